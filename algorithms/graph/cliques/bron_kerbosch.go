@@ -0,0 +1,188 @@
+/* Algorithm: Bron-Kerbosch */
+
+package cliques
+
+import (
+	"azure/data_structures/graph"
+	"iter"
+	"slices"
+)
+
+/*
+Maximal cliques of an Undirected Graph, by Bron-Kerbosch with pivoting.
+Outer level follows a degeneracy order to keep candidate sets small.
+- Time: O(d.V.3^(d/3)) & Space: O(E + V), d ~ degeneracy.
+*/
+func MaximalCliques(G *graph.Graph) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		adj := simpleNeighbors(G)
+		bronKerbosch(adj, degeneracyOrder(adj), yield)
+	}
+}
+
+/*
+Maximal independent sets of an Undirected Graph ~ maximal cliques of its complement.
+- Time: O(3^(V/3)) & Space: O(V^2).
+*/
+func MaximalIndependentSets(G *graph.Graph) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		adj := simpleNeighbors(G)
+		co := make([][]int, G.V)
+		for v := range G.V {
+			i := 0
+			for w := range G.V {
+				for i < len(adj[v]) && adj[v][i] < w {
+					i++
+				}
+
+				if w != v && (i == len(adj[v]) || adj[v][i] != w) {
+					co[v] = append(co[v], w)
+				}
+			}
+		}
+
+		bronKerbosch(co, degeneracyOrder(co), yield)
+	}
+}
+
+func bronKerbosch(adj [][]int, order []int, yield func([]int) bool) {
+	pos := make([]int, len(adj))
+	for i, v := range order {
+		pos[v] = i
+	}
+
+	var R []int
+	var expand func(P, X []int) bool
+	expand = func(P, X []int) bool {
+		if len(P) == 0 {
+			if len(X) == 0 {
+				clique := slices.Clone(R)
+				slices.Sort(clique)
+				return yield(clique)
+			}
+
+			return true
+		}
+
+		// Pivot u covers the most candidates -> branch on P \ N(u) only.
+		pivot, most := -1, -1
+		for _, set := range [][]int{P, X} {
+			for _, u := range set {
+				if k := countCommon(P, adj[u]); k > most {
+					pivot, most = u, k
+				}
+			}
+		}
+
+		for _, v := range difference(P, adj[pivot]) {
+			R = append(R, v)
+			ok := expand(intersect(P, adj[v]), intersect(X, adj[v]))
+			R = R[:len(R)-1]
+			if !ok {
+				return false
+			}
+
+			P = remove(P, v)
+			X = insert(X, v)
+		}
+
+		return true
+	}
+
+	for _, v := range order {
+		var P, X []int
+		for _, w := range adj[v] {
+			if pos[w] > pos[v] {
+				P = append(P, w)
+			} else {
+				X = append(X, w)
+			}
+		}
+
+		R = append(R[:0], v)
+		if !expand(P, X) {
+			return
+		}
+	}
+}
+
+/* Distinct neighbors of every vertex in ascending order, self-loops dropped. */
+func simpleNeighbors(G *graph.Graph) [][]int {
+	adj := make([][]int, G.V)
+	for v := range G.V {
+		for e := range G.Adjacent(v) {
+			if w := e.Other(v); w != v {
+				adj[v] = append(adj[v], w)
+			}
+		}
+
+		slices.Sort(adj[v])
+		adj[v] = slices.Compact(adj[v])
+	}
+
+	return adj
+}
+
+// Set operations over ascending slices.
+
+func intersect(a, b []int) []int {
+	var res []int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			res = append(res, a[i])
+			i++
+			j++
+		}
+	}
+
+	return res
+}
+
+func countCommon(a, b []int) int {
+	count := 0
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			count++
+			i++
+			j++
+		}
+	}
+
+	return count
+}
+
+func difference(a, b []int) []int {
+	var res []int
+	j := 0
+	for _, x := range a {
+		for j < len(b) && b[j] < x {
+			j++
+		}
+
+		if j == len(b) || b[j] != x {
+			res = append(res, x)
+		}
+	}
+
+	return res
+}
+
+func remove(a []int, x int) []int {
+	i, _ := slices.BinarySearch(a, x)
+	return slices.Delete(slices.Clone(a), i, i+1)
+}
+
+func insert(a []int, x int) []int {
+	i, _ := slices.BinarySearch(a, x)
+	return slices.Insert(slices.Clone(a), i, x)
+}
@@ -0,0 +1,101 @@
+/* Algorithm: k-Core Decomposition (Batagelj-Zaversnik) */
+
+package cliques
+
+import "azure/data_structures/graph"
+
+/*
+Core number of every vertex: the largest k s.t. it lies in the k-core.
+Parallel edges & self-loops are ignored.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func CoreNumbers(G *graph.Graph) []int {
+	core, _ := peel(simpleNeighbors(G))
+	return core
+}
+
+/* Vertices of the k-core, i.e. the maximal subgraph of min degree k. */
+func KCore(G *graph.Graph, k int) []int {
+	var res []int
+	for v, c := range CoreNumbers(G) {
+		if c >= k {
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
+/* Max core number over all vertices. */
+func Degeneracy(G *graph.Graph) int {
+	res := 0
+	for _, c := range CoreNumbers(G) {
+		res = max(res, c)
+	}
+
+	return res
+}
+
+/* Order in which vertices are peeled off ~ a degeneracy order. */
+func degeneracyOrder(adj [][]int) []int {
+	_, order := peel(adj)
+	return order
+}
+
+/* Repeatedly remove a min-degree vertex, with vertices bucketed by degree. */
+func peel(adj [][]int) (core, order []int) {
+	V := len(adj)
+	deg := make([]int, V)
+	maxDeg := 0
+	for v := range V {
+		deg[v] = len(adj[v])
+		maxDeg = max(maxDeg, deg[v])
+	}
+
+	// Counting sort by degree: vert[pos[v]] = v, bin[d] ~ 1st slot of degree d.
+	bin := make([]int, maxDeg+1)
+	for v := range V {
+		bin[deg[v]]++
+	}
+
+	start := 0
+	for d := range bin {
+		bin[d], start = start, start+bin[d]
+	}
+
+	vert := make([]int, V)
+	pos := make([]int, V)
+	for v := range V {
+		pos[v] = bin[deg[v]]
+		vert[pos[v]] = v
+		bin[deg[v]]++
+	}
+
+	for d := maxDeg; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+
+	bin[0] = 0
+
+	for i := range V {
+		v := vert[i]
+		for _, w := range adj[v] {
+			if deg[w] <= deg[v] {
+				continue
+			}
+
+			// Swap w to the front of its bucket, then shrink its degree.
+			dw := deg[w]
+			pw, ps := pos[w], bin[dw]
+			if u := vert[ps]; u != w {
+				vert[pw], vert[ps] = u, w
+				pos[u], pos[w] = pw, ps
+			}
+
+			bin[dw]++
+			deg[w]--
+		}
+	}
+
+	return deg, vert
+}
@@ -0,0 +1,74 @@
+/* Algorithm: Exact Coloring (Backtracking) */
+
+package coloring
+
+import "azure/data_structures/graph"
+
+/*
+Chromatic coloring by backtracking, meant for small graphs.
+- Time: O(k^V) worst & Space: O(E + V).
+*/
+func ExactColoring(G *graph.Graph) (*Coloring, error) {
+	adj, err := conflicts(G)
+	if err != nil {
+		return nil, err
+	}
+
+	// DSatur gives both an upper bound & a good vertex order.
+	best := dsatur(adj)
+	order := make([]int, 0, G.V)
+	for c := range best.Count {
+		for v := range G.V {
+			if best.Colors[v] == c {
+				order = append(order, v)
+			}
+		}
+	}
+
+	colors := make([]int, G.V)
+	for k := best.Count - 1; k >= 1; k-- {
+		for v := range G.V {
+			colors[v] = -1
+		}
+
+		var try func(int, int) bool
+		try = func(i, used int) bool {
+			if i == len(order) {
+				return true
+			}
+
+			v := order[i]
+
+			// Symmetry breaking: at most 1 brand new color.
+			for c := range min(used+1, k) {
+				clash := false
+				for _, w := range adj[v] {
+					if colors[w] == c {
+						clash = true
+						break
+					}
+				}
+
+				if clash {
+					continue
+				}
+
+				colors[v] = c
+				if try(i+1, max(used, c+1)) {
+					return true
+				}
+			}
+
+			colors[v] = -1
+			return false
+		}
+
+		if !try(0, 0) {
+			break
+		}
+
+		best = &Coloring{Colors: append([]int(nil), colors...), Count: k}
+	}
+
+	return best, nil
+}
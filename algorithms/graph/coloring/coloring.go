@@ -0,0 +1,71 @@
+/* API: Vertex Coloring */
+
+package coloring
+
+import (
+	"azure/data_structures/graph"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrSelfLoop = errors.New("coloring: self-loop has no proper coloring")
+
+type Coloring struct {
+	Colors []int // vertex -> color in [0, Count)
+	Count  int
+}
+
+/* Check that no edge joins 2 vertices of the same color. */
+func (c *Coloring) IsProper(G *graph.Graph) bool {
+	for e := range G.Edges() {
+		v := e.Head()
+		if c.Colors[v] == c.Colors[e.Other(v)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+/* Distinct vertices each vertex must differ from, failing on self-loops. */
+func conflicts(G *graph.Graph) ([][]int, error) {
+	adj := make([][]int, G.V)
+	for v := range G.V {
+		for e := range G.Adjacent(v) {
+			w := e.Other(v)
+			if w == v {
+				return nil, fmt.Errorf("%w: at vertex %d", ErrSelfLoop, v)
+			}
+
+			adj[v] = append(adj[v], w)
+		}
+
+		slices.Sort(adj[v])
+		adj[v] = slices.Compact(adj[v])
+	}
+
+	return adj, nil
+}
+
+/* Smallest color unused by already-colored neighbors. */
+func firstFit(adj []int, colors []int, taken []bool) int {
+	for _, w := range adj {
+		if colors[w] != -1 && colors[w] < len(taken) {
+			taken[colors[w]] = true
+		}
+	}
+
+	c := 0
+	for c < len(taken) && taken[c] {
+		c++
+	}
+
+	for _, w := range adj {
+		if colors[w] != -1 && colors[w] < len(taken) {
+			taken[colors[w]] = false
+		}
+	}
+
+	return c
+}
@@ -0,0 +1,75 @@
+/* Algorithm: DSatur */
+
+package coloring
+
+import (
+	"azure/data_structures/graph"
+	pq "azure/data_structures/priority_queue"
+)
+
+/*
+Greedy coloring, always picking the most saturated vertex.
+- Time: O((E + V).logV) & Space: O(E + V).
+*/
+func DSatur(G *graph.Graph) (*Coloring, error) {
+	adj, err := conflicts(G)
+	if err != nil {
+		return nil, err
+	}
+
+	return dsatur(adj), nil
+}
+
+func dsatur(adj [][]int) *Coloring {
+	V := len(adj)
+	res := &Coloring{Colors: make([]int, V)}
+	for v := range V {
+		res.Colors[v] = -1
+	}
+
+	// Saturation ~ distinct colors among neighbors.
+	seen := make([]map[int]bool, V)
+	type entry struct {
+		vertex, sat, deg int
+	}
+
+	maxpq := pq.NewPQ(func(a, b entry) bool {
+		if a.sat != b.sat {
+			return a.sat > b.sat
+		}
+
+		if a.deg != b.deg {
+			return a.deg > b.deg
+		}
+
+		return a.vertex < b.vertex
+	})
+
+	for v := range V {
+		seen[v] = make(map[int]bool)
+		maxpq.Enqueue(entry{v, 0, len(adj[v])})
+	}
+
+	taken := make([]bool, V+1)
+	for !maxpq.IsEmpty() {
+		top := maxpq.Dequeue()
+		v := top.vertex
+
+		// Colored already or outdated saturation -> Skip.
+		if res.Colors[v] != -1 || top.sat != len(seen[v]) {
+			continue
+		}
+
+		res.Colors[v] = firstFit(adj[v], res.Colors, taken)
+		res.Count = max(res.Count, res.Colors[v]+1)
+
+		for _, w := range adj[v] {
+			if res.Colors[w] == -1 && !seen[w][res.Colors[v]] {
+				seen[w][res.Colors[v]] = true
+				maxpq.Enqueue(entry{w, len(seen[w]), len(adj[w])})
+			}
+		}
+	}
+
+	return res
+}
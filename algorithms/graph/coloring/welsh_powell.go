@@ -0,0 +1,42 @@
+/* Algorithm: Welsh-Powell */
+
+package coloring
+
+import (
+	"azure/data_structures/graph"
+	"cmp"
+	"slices"
+)
+
+/*
+Greedy coloring in descending degree order.
+- Time: O(V.logV + E) & Space: O(E + V).
+*/
+func WelshPowell(G *graph.Graph) (*Coloring, error) {
+	adj, err := conflicts(G)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]int, G.V)
+	for v := range G.V {
+		order[v] = v
+	}
+
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(len(adj[b]), len(adj[a]))
+	})
+
+	res := &Coloring{Colors: make([]int, G.V)}
+	for v := range G.V {
+		res.Colors[v] = -1
+	}
+
+	taken := make([]bool, G.V+1)
+	for _, v := range order {
+		res.Colors[v] = firstFit(adj[v], res.Colors, taken)
+		res.Count = max(res.Count, res.Colors[v]+1)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,10 @@
+/* API: Eulerian Trail */
+
+package eulerian
+
+import "errors"
+
+var (
+	ErrNoEulerianCircuit = errors.New("eulerian: no Eulerian circuit")
+	ErrNoEulerianPath    = errors.New("eulerian: no Eulerian path")
+)
@@ -0,0 +1,237 @@
+/* Algorithm: Hierholzer */
+
+package eulerian
+
+import (
+	"azure/algorithms/array"
+	"azure/data_structures/graph"
+	stackqueue "azure/data_structures/stack_queue"
+	"fmt"
+)
+
+/*
+Eulerian circuit of an Undirected Graph, edges oriented along the trail.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func EulerianCircuit(G *graph.Graph) ([]graph.Edge, error) {
+	start := -1
+	for v := range G.V {
+		if G.Degree(v)%2 != 0 {
+			return nil, fmt.Errorf("%w: vertex %d has odd degree", ErrNoEulerianCircuit, v)
+		}
+
+		if start == -1 && G.Degree(v) > 0 {
+			start = v
+		}
+	}
+
+	trail, ok := undirectedTrail(G, start)
+	if !ok {
+		return nil, fmt.Errorf("%w: edges span several components", ErrNoEulerianCircuit)
+	}
+
+	return trail, nil
+}
+
+/*
+Eulerian path of an Undirected Graph, edges oriented along the trail.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func EulerianPath(G *graph.Graph) ([]graph.Edge, error) {
+	start, odd := -1, 0
+	for v := range G.V {
+		if G.Degree(v)%2 != 0 {
+			odd++
+			if odd == 1 { // Must start at an odd vertex.
+				start = v
+			}
+		} else if start == -1 && G.Degree(v) > 0 {
+			start = v
+		}
+	}
+
+	if odd > 2 {
+		return nil, fmt.Errorf("%w: %d vertices have odd degree", ErrNoEulerianPath, odd)
+	}
+
+	trail, ok := undirectedTrail(G, start)
+	if !ok {
+		return nil, fmt.Errorf("%w: edges span several components", ErrNoEulerianPath)
+	}
+
+	return trail, nil
+}
+
+/*
+Eulerian circuit of a Directed Graph.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func DirectedEulerianCircuit(G *graph.Digraph) ([]graph.Edge, error) {
+	start := -1
+	for v := range G.V {
+		if G.Outdegree(v) != G.Indegree(v) {
+			return nil, fmt.Errorf(
+				"%w: vertex %d has outdegree %d but indegree %d",
+				ErrNoEulerianCircuit, v, G.Outdegree(v), G.Indegree(v),
+			)
+		}
+
+		if start == -1 && G.Outdegree(v) > 0 {
+			start = v
+		}
+	}
+
+	trail, ok := directedTrail(G, start)
+	if !ok {
+		return nil, fmt.Errorf("%w: edges span several components", ErrNoEulerianCircuit)
+	}
+
+	return trail, nil
+}
+
+/*
+Eulerian path of a Directed Graph.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func DirectedEulerianPath(G *graph.Digraph) ([]graph.Edge, error) {
+	start, heads, tails := -1, 0, 0
+	for v := range G.V {
+		switch diff := G.Outdegree(v) - G.Indegree(v); {
+		case diff == 1: // Must start here.
+			heads++
+			start = v
+		case diff == -1:
+			tails++
+		case diff != 0:
+			return nil, fmt.Errorf(
+				"%w: vertex %d has outdegree %d but indegree %d",
+				ErrNoEulerianPath, v, G.Outdegree(v), G.Indegree(v),
+			)
+		}
+	}
+
+	if heads > 1 || tails > 1 {
+		return nil, fmt.Errorf(
+			"%w: %d vertices with surplus out-edges, %d with surplus in-edges",
+			ErrNoEulerianPath, heads, tails,
+		)
+	}
+
+	for v := range G.V {
+		if start == -1 && G.Outdegree(v) > 0 {
+			start = v
+		}
+	}
+
+	trail, ok := directedTrail(G, start)
+	if !ok {
+		return nil, fmt.Errorf("%w: edges span several components", ErrNoEulerianPath)
+	}
+
+	return trail, nil
+}
+
+/* Hierholzer over undirected edges, false if some edge is unreached. */
+func undirectedTrail(G *graph.Graph, start int) ([]graph.Edge, bool) {
+	var edges []graph.Edge
+	adj := make([][]int, G.V)
+	for e := range G.Edges() {
+		v := e.Head()
+		w := e.Other(v)
+		adj[v] = append(adj[v], len(edges))
+		if v != w {
+			adj[w] = append(adj[w], len(edges))
+		}
+
+		edges = append(edges, e)
+	}
+
+	used := make([]bool, len(edges))
+	order := hierholzer(start, adj, used, func(id, v int) int {
+		return edges[id].Other(v)
+	})
+
+	if len(order) != len(edges) {
+		return nil, false
+	}
+
+	// Orient each edge in walking direction.
+	trail := make([]graph.Edge, len(order))
+	v := start
+	for i, id := range order {
+		w := edges[id].Other(v)
+		trail[i] = *graph.NewEdge(v, w, edges[id].Weight())
+		v = w
+	}
+
+	return trail, true
+}
+
+/* Hierholzer over directed edges, false if some edge is unreached. */
+func directedTrail(G *graph.Digraph, start int) ([]graph.Edge, bool) {
+	var edges []graph.Edge
+	adj := make([][]int, G.V)
+	for e := range G.Edges() {
+		adj[e.Head()] = append(adj[e.Head()], len(edges))
+		edges = append(edges, e)
+	}
+
+	used := make([]bool, len(edges))
+	order := hierholzer(start, adj, used, func(id, v int) int {
+		return edges[id].Other(v)
+	})
+
+	if len(order) != len(edges) {
+		return nil, false
+	}
+
+	trail := make([]graph.Edge, len(order))
+	for i, id := range order {
+		trail[i] = edges[id]
+	}
+
+	return trail, true
+}
+
+/* Edge IDs of the trail from 'start', splicing in sub-circuits. */
+func hierholzer(start int, adj [][]int, used []bool, other func(int, int) int) []int {
+	if start == -1 { // No edges at all.
+		return nil
+	}
+
+	type step struct {
+		vertex, via int
+	}
+
+	next := make([]int, len(adj)) // vertex -> first unchecked edge
+	var order []int
+
+	stack := stackqueue.NewStack[step](len(used) + 1)
+	stack.Push(step{start, -1})
+
+	for !stack.IsEmpty() {
+		top, _ := stack.Peek()
+		v := top.vertex
+
+		for next[v] < len(adj[v]) && used[adj[v][next[v]]] {
+			next[v]++
+		}
+
+		// Dead end -> Edge joins the trail (in reverse).
+		if next[v] == len(adj[v]) {
+			stack.Pop()
+			if top.via != -1 {
+				order = append(order, top.via)
+			}
+
+			continue
+		}
+
+		id := adj[v][next[v]]
+		used[id] = true
+		stack.Push(step{other(id, v), id})
+	}
+
+	array.Reverse(order)
+	return order
+}
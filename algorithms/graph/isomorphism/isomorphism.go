@@ -0,0 +1,83 @@
+/* API: Graph Isomorphism */
+
+package isomorphism
+
+import (
+	"azure/data_structures/graph"
+	"slices"
+)
+
+/* Optional compatibility predicates, nil means always compatible. */
+type Options struct {
+	Vertex func(u, v int) bool        // pattern vertex u ~ target vertex v
+	Edge   func(e, f graph.Edge) bool // pattern edge e ~ target edge f
+}
+
+/* Adjacency of either graph kind, as seen by the matcher. */
+type shape struct {
+	V, E       int
+	directed   bool
+	succ, pred [][]int // distinct, self-loops excluded
+	edges      map[[2]int][]graph.Edge
+}
+
+func undirectedShape(G *graph.Graph) *shape {
+	s := &shape{V: G.V, edges: make(map[[2]int][]graph.Edge)}
+	s.succ = make([][]int, G.V)
+	s.pred = s.succ
+
+	for e := range G.Edges() {
+		v, w := e.Head(), e.Other(e.Head())
+		s.add(min(v, w), max(v, w), e)
+	}
+
+	for v := range G.V {
+		slices.Sort(s.succ[v])
+		s.succ[v] = slices.Compact(s.succ[v])
+	}
+
+	return s
+}
+
+func directedShape(G *graph.Digraph) *shape {
+	s := &shape{V: G.V, directed: true, edges: make(map[[2]int][]graph.Edge)}
+	s.succ = make([][]int, G.V)
+	s.pred = make([][]int, G.V)
+
+	for e := range G.Edges() {
+		s.add(e.Head(), e.Other(e.Head()), e)
+	}
+
+	for v := range G.V {
+		slices.Sort(s.succ[v])
+		s.succ[v] = slices.Compact(s.succ[v])
+		slices.Sort(s.pred[v])
+		s.pred[v] = slices.Compact(s.pred[v])
+	}
+
+	return s
+}
+
+func (s *shape) add(v, w int, e graph.Edge) {
+	s.E++
+	s.edges[[2]int{v, w}] = append(s.edges[[2]int{v, w}], e)
+	if v == w {
+		return
+	}
+
+	s.succ[v] = append(s.succ[v], w)
+	if s.directed {
+		s.pred[w] = append(s.pred[w], v)
+	} else {
+		s.succ[w] = append(s.succ[w], v)
+	}
+}
+
+/* Parallel edges from v to w, either way round if undirected. */
+func (s *shape) between(v, w int) []graph.Edge {
+	if !s.directed && v > w {
+		v, w = w, v
+	}
+
+	return s.edges[[2]int{v, w}]
+}
@@ -0,0 +1,338 @@
+/* Algorithm: VF2 */
+
+package isomorphism
+
+import (
+	"azure/data_structures/graph"
+	"iter"
+	"slices"
+)
+
+type mode int
+
+const (
+	isomorphism  mode = iota // bijection preserving edges & non-edges
+	induced                  // pattern ~ induced subgraph of target
+	monomorphism             // pattern ~ any subgraph of target
+)
+
+/*
+Isomorphisms between 2 Undirected Graphs, as maps from G's vertices to H's.
+- Time: O(V!.V) worst & Space: O(E + V).
+*/
+func Isomorphisms(G, H *graph.Graph, opts *Options) iter.Seq[[]int] {
+	return search(undirectedShape(G), undirectedShape(H), opts, isomorphism)
+}
+
+/*
+Embeddings of pattern as an induced subgraph of target.
+- Time: O(V^P.P) worst & Space: O(E + V).
+*/
+func SubgraphIsomorphisms(pattern, target *graph.Graph, opts *Options) iter.Seq[[]int] {
+	return search(undirectedShape(pattern), undirectedShape(target), opts, induced)
+}
+
+/*
+Embeddings of pattern as a (not necessarily induced) subgraph of target.
+- Time: O(V^P.P) worst & Space: O(E + V).
+*/
+func SubgraphMonomorphisms(pattern, target *graph.Graph, opts *Options) iter.Seq[[]int] {
+	return search(undirectedShape(pattern), undirectedShape(target), opts, monomorphism)
+}
+
+/*
+Isomorphisms between 2 Directed Graphs, as maps from G's vertices to H's.
+- Time: O(V!.V) worst & Space: O(E + V).
+*/
+func DirectedIsomorphisms(G, H *graph.Digraph, opts *Options) iter.Seq[[]int] {
+	return search(directedShape(G), directedShape(H), opts, isomorphism)
+}
+
+/*
+Embeddings of pattern as an induced subgraph of target, edge directions kept.
+- Time: O(V^P.P) worst & Space: O(E + V).
+*/
+func DirectedSubgraphIsomorphisms(pattern, target *graph.Digraph, opts *Options) iter.Seq[[]int] {
+	return search(directedShape(pattern), directedShape(target), opts, induced)
+}
+
+/*
+Embeddings of pattern as any subgraph of target, edge directions kept.
+- Time: O(V^P.P) worst & Space: O(E + V).
+*/
+func DirectedSubgraphMonomorphisms(pattern, target *graph.Digraph, opts *Options) iter.Seq[[]int] {
+	return search(directedShape(pattern), directedShape(target), opts, monomorphism)
+}
+
+type matcher struct {
+	P, T         *shape
+	opts         Options
+	mode         mode
+	core1, core2 []int // pattern -> target & back, -1 if unmapped
+	in1, out1    []int // depth a pattern vertex joined the terminal sets, 0 if not
+	in2, out2    []int // same for the target
+	depth        int
+}
+
+func search(P, T *shape, opts *Options, mode mode) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		switch {
+		case mode == isomorphism && (P.V != T.V || P.E != T.E):
+			return
+		case P.V > T.V || P.E > T.E:
+			return
+		}
+
+		m := &matcher{
+			P: P, T: T, mode: mode,
+			core1: make([]int, P.V), core2: make([]int, T.V),
+			in1: make([]int, P.V), out1: make([]int, P.V),
+			in2: make([]int, T.V), out2: make([]int, T.V),
+		}
+
+		if opts != nil {
+			m.opts = *opts
+		}
+
+		for v := range P.V {
+			m.core1[v] = -1
+		}
+
+		for v := range T.V {
+			m.core2[v] = -1
+		}
+
+		m.match(yield)
+	}
+}
+
+func (m *matcher) match(yield func([]int) bool) bool {
+	if m.depth == m.P.V {
+		return yield(slices.Clone(m.core1))
+	}
+
+	p, cands := m.candidates()
+	for _, t := range cands {
+		if !m.feasible(p, t) {
+			continue
+		}
+
+		m.push(p, t)
+		ok := m.match(yield)
+		m.pop(p, t)
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Next pattern vertex & its target candidates: out-terminal sets first, then
+in-terminal sets, otherwise any unmapped vertex.
+*/
+func (m *matcher) candidates() (int, []int) {
+	for _, sets := range [][2][]int{{m.out1, m.out2}, {m.in1, m.in2}} {
+		p := -1
+		for v := range m.P.V {
+			if m.core1[v] == -1 && sets[0][v] > 0 {
+				p = v
+				break
+			}
+		}
+
+		var cands []int
+		for v := range m.T.V {
+			if m.core2[v] == -1 && sets[1][v] > 0 {
+				cands = append(cands, v)
+			}
+		}
+
+		if p != -1 && len(cands) > 0 {
+			return p, cands
+		}
+	}
+
+	p := slices.Index(m.core1, -1)
+
+	var cands []int
+	for v := range m.T.V {
+		if m.core2[v] == -1 {
+			cands = append(cands, v)
+		}
+	}
+
+	return p, cands
+}
+
+func (m *matcher) feasible(p, t int) bool {
+	if m.opts.Vertex != nil && !m.opts.Vertex(p, t) {
+		return false
+	}
+
+	if !m.edgesMatch(m.P.between(p, p), m.T.between(t, t)) {
+		return false
+	}
+
+	// Every mapped neighbor of p must be mirrored around t.
+	for _, x := range m.P.succ[p] {
+		if y := m.core1[x]; y != -1 && !m.edgesMatch(m.P.between(p, x), m.T.between(t, y)) {
+			return false
+		}
+	}
+
+	if m.P.directed {
+		for _, x := range m.P.pred[p] {
+			if y := m.core1[x]; y != -1 && !m.edgesMatch(m.P.between(x, p), m.T.between(y, t)) {
+				return false
+			}
+		}
+	}
+
+	if m.mode == monomorphism {
+		return true
+	}
+
+	// Non-edges must be kept as well.
+	for _, y := range m.T.succ[t] {
+		if x := m.core2[y]; x != -1 && len(m.P.between(p, x)) == 0 {
+			return false
+		}
+	}
+
+	if m.T.directed {
+		for _, y := range m.T.pred[t] {
+			if x := m.core2[y]; x != -1 && len(m.P.between(x, p)) == 0 {
+				return false
+			}
+		}
+	}
+
+	// Look-ahead: unmapped neighbors split by terminal set membership.
+	fits := func(a, b [3]int) bool {
+		if m.mode == isomorphism {
+			return a == b
+		}
+
+		return a[0] <= b[0] && a[1] <= b[1] && a[2] <= b[2]
+	}
+
+	return fits(m.census(m.P.succ[p], m.core1, m.in1, m.out1), m.census(m.T.succ[t], m.core2, m.in2, m.out2)) &&
+		fits(m.census(m.P.pred[p], m.core1, m.in1, m.out1), m.census(m.T.pred[t], m.core2, m.in2, m.out2))
+}
+
+/* Unmapped vertices among adj in: in-terminal, out-terminal, neither. */
+func (m *matcher) census(adj, core, in, out []int) [3]int {
+	var res [3]int
+	for _, v := range adj {
+		if core[v] != -1 {
+			continue
+		}
+
+		if in[v] > 0 {
+			res[0]++
+		}
+
+		if out[v] > 0 {
+			res[1]++
+		}
+
+		if in[v] == 0 && out[v] == 0 {
+			res[2]++
+		}
+	}
+
+	return res
+}
+
+/*
+Parallel pattern edges a against target edges b: counts must agree (or
+a may be fewer for monomorphisms), and with an edge predicate every
+pattern edge needs a distinct compatible partner.
+*/
+func (m *matcher) edgesMatch(a, b []graph.Edge) bool {
+	if len(a) > len(b) || m.mode != monomorphism && len(a) != len(b) {
+		return false
+	}
+
+	if m.opts.Edge == nil || len(a) == 0 {
+		return true
+	}
+
+	// Bipartite matching by augmenting paths, the lists are tiny.
+	owner := make([]int, len(b))
+	for j := range owner {
+		owner[j] = -1
+	}
+
+	var augment func(int, []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for j := range b {
+			if seen[j] || !m.opts.Edge(a[i], b[j]) {
+				continue
+			}
+
+			seen[j] = true
+			if owner[j] == -1 || augment(owner[j], seen) {
+				owner[j] = i
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i := range a {
+		if !augment(i, make([]bool, len(b))) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *matcher) push(p, t int) {
+	m.depth++
+	m.core1[p], m.core2[t] = t, p
+
+	enter := func(v int, adj []int, set []int) {
+		if set[v] == 0 {
+			set[v] = m.depth
+		}
+
+		for _, w := range adj {
+			if set[w] == 0 {
+				set[w] = m.depth
+			}
+		}
+	}
+
+	enter(p, m.P.succ[p], m.out1)
+	enter(p, m.P.pred[p], m.in1)
+	enter(t, m.T.succ[t], m.out2)
+	enter(t, m.T.pred[t], m.in2)
+}
+
+func (m *matcher) pop(p, t int) {
+	leave := func(v int, adj []int, set []int) {
+		if set[v] == m.depth {
+			set[v] = 0
+		}
+
+		for _, w := range adj {
+			if set[w] == m.depth {
+				set[w] = 0
+			}
+		}
+	}
+
+	leave(p, m.P.succ[p], m.out1)
+	leave(p, m.P.pred[p], m.in1)
+	leave(t, m.T.succ[t], m.out2)
+	leave(t, m.T.pred[t], m.in2)
+
+	m.core1[p], m.core2[t] = -1, -1
+	m.depth--
+}
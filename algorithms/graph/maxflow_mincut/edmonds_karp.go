@@ -8,7 +8,8 @@ import (
 )
 
 /*
-A Shortest Path variant of Ford-Fulkerson for finding Maxflow.
+A Shortest Path variant of Ford-Fulkerson for finding Maxflow, left on
+G's edges (read each through Flow()).
 - Time: O(E^2.V) & Space: O(V).
 */
 func EdmondsKarpMaxFlow(G *graph.FlowNetwork, s, t int) *MinCut {
@@ -25,7 +26,7 @@ func EdmondsKarpMaxFlow(G *graph.FlowNetwork, s, t int) *MinCut {
 		// Calculate bottleneck of path.
 		for v := t; v != s; v = ek.edgeTo[v].Other(v) {
 			e := ek.edgeTo[v]
-			bottleneck = min(bottleneck, e.ResidualTo(v))
+			bottleneck = min(bottleneck, e.ResidualCapacityTo(v))
 		}
 
 		// Add delta residual flow to path.
@@ -44,7 +45,7 @@ func EdmondsKarpMaxFlow(G *graph.FlowNetwork, s, t int) *MinCut {
 			for e := range G.Adjacent(v) {
 				w := e.Other(v)
 				if w != e.Head() && !ek.marked[w] {
-					mincut = append(mincut, &e)
+					mincut = append(mincut, e)
 				}
 			}
 		}
@@ -59,7 +60,7 @@ func EdmondsKarpMaxFlow(G *graph.FlowNetwork, s, t int) *MinCut {
 /* Check & find Shortest augmenting path */
 func (ek *EdmondsKarp) hasAugmentingPath(G *graph.FlowNetwork, s, t int) bool {
 	for v := range G.V {
-		ek.marked[v] = true
+		ek.marked[v] = false
 		ek.edgeTo[v] = nil
 	}
 
@@ -78,8 +79,8 @@ func (ek *EdmondsKarp) hasAugmentingPath(G *graph.FlowNetwork, s, t int) bool {
 			w := e.Other(v)
 
 			// Avoid full forward & empty backward flow edges.
-			if !ek.marked[w] && e.ResidualTo(w) > 0 {
-				ek.edgeTo[w] = &e
+			if !ek.marked[w] && e.ResidualCapacityTo(w) > 0 {
+				ek.edgeTo[w] = e
 				ek.marked[w] = true
 				queue.Enqueue(w)
 			}
@@ -0,0 +1,160 @@
+/* Algorithm: Flow Decomposition */
+
+package maxflow
+
+import (
+	graph "azure/data_structures/flow_network"
+	"fmt"
+)
+
+type FlowPath struct {
+	Edges  []*graph.FlowEdge
+	Amount int
+}
+
+type FlowDecomposition struct {
+	Paths  []FlowPath // s-t paths
+	Cycles []FlowPath // circulating flow
+}
+
+/*
+Decompose a feasible s-t flow into at most E paths & cycles. Circulations
+(including those through s or t) are peeled off first, so the paths carry
+exactly the flow value.
+- Time: O(E.(E + V)) & Space: O(E + V).
+*/
+func DecomposeFlow(G *graph.FlowNetwork, s, t int) (*FlowDecomposition, error) {
+	if flowErr := G.CheckFlow(s, t); flowErr != nil {
+		return nil, flowErr
+	}
+
+	// Only edges carrying flow take part.
+	out := make([][]*graph.FlowEdge, G.V)
+	remaining := make(map[*graph.FlowEdge]int)
+	for e := range G.Edges() {
+		if e.Flow() > 0 {
+			out[e.Head()] = append(out[e.Head()], e)
+			remaining[e] = e.Flow()
+		}
+	}
+
+	// Subtract the bottleneck along a path or cycle.
+	peel := func(edges []*graph.FlowEdge) FlowPath {
+		amount := INF
+		for _, e := range edges {
+			amount = min(amount, remaining[e])
+		}
+
+		for _, e := range edges {
+			remaining[e] -= amount
+		}
+
+		return FlowPath{
+			Edges:  append([]*graph.FlowEdge(nil), edges...),
+			Amount: amount,
+		}
+	}
+
+	res := &FlowDecomposition{}
+
+	// Cancel cycles by DFS: a finished vertex lies on no remaining cycle.
+	const (
+		unseen = iota
+		onWalk
+		finished
+	)
+
+	state := make([]int, G.V)
+	posOf := make([]int, G.V) // vertex -> position on current walk
+	next := make([]int, G.V)  // edges before it are useless for good
+
+	for root := range G.V {
+		if state[root] != unseen {
+			continue
+		}
+
+		verts := []int{root}
+		var path []*graph.FlowEdge
+		state[root], posOf[root] = onWalk, 0
+
+		for len(verts) > 0 {
+			v := verts[len(verts)-1]
+			var e *graph.FlowEdge
+			for ; next[v] < len(out[v]); next[v]++ {
+				f := out[v][next[v]]
+				if remaining[f] > 0 && state[f.Other(v)] != finished {
+					e = f
+					break
+				}
+			}
+
+			// Dead end -> Backtrack.
+			if e == nil {
+				state[v] = finished
+				verts = verts[:len(verts)-1]
+				if len(path) > 0 {
+					path = path[:len(path)-1]
+				}
+
+				continue
+			}
+
+			w := e.Other(v)
+			if state[w] == unseen {
+				state[w], posOf[w] = onWalk, len(verts)
+				verts = append(verts, w)
+				path = append(path, e)
+				continue
+			}
+
+			// Back on the walk -> Cycle, then unwind to its entry.
+			at := posOf[w]
+			res.Cycles = append(res.Cycles, peel(append(path[at:len(path):len(path)], e)))
+			for _, x := range verts[at+1:] {
+				state[x] = unseen
+			}
+
+			verts = verts[:at+1]
+			path = path[:at]
+		}
+	}
+
+	// Acyclic leftover: every walk from s must end at t.
+	for i := range next {
+		next[i] = 0
+	}
+
+	advance := func(v int) *graph.FlowEdge {
+		for ; next[v] < len(out[v]); next[v]++ {
+			if e := out[v][next[v]]; remaining[e] > 0 {
+				return e
+			}
+		}
+
+		return nil
+	}
+
+	for s != t && advance(s) != nil {
+		var path []*graph.FlowEdge
+		for v := s; v != t; {
+			e := advance(v)
+			if e == nil {
+				return nil, fmt.Errorf("%w: flow stops at vertex %d", graph.ErrUnconservedFlow, v)
+			}
+
+			path = append(path, e)
+			v = e.Other(v)
+		}
+
+		res.Paths = append(res.Paths, peel(path))
+	}
+
+	for e, left := range remaining {
+		if left > 0 {
+			return nil, fmt.Errorf("%w: flow runs from sink %d to source %d on edge %d->%d",
+				graph.ErrUnconservedFlow, t, s, e.Head(), e.Other(e.Head()))
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,39 @@
+package maxflow
+
+import (
+	graph "azure/data_structures/flow_network"
+	"errors"
+	"testing"
+)
+
+func TestDecomposeFlowCycleThroughSource(t *testing.T) {
+	G := graph.NewFlowNetwork(2)
+	G.AddEdge(*graph.NewFlowEdge(0, 1, 1)).AddResidualTo(1, 1)
+	G.AddEdge(*graph.NewFlowEdge(1, 0, 1)).AddResidualTo(0, 1)
+
+	if err := G.CheckFlow(0, 1); err != nil {
+		t.Fatalf("CheckFlow: %v", err)
+	}
+
+	d, err := DecomposeFlow(G, 0, 1)
+	if err != nil {
+		t.Fatalf("DecomposeFlow: %v", err)
+	}
+
+	if len(d.Paths) != 0 {
+		t.Errorf("got %d paths, want 0 for a zero-value flow", len(d.Paths))
+	}
+
+	if len(d.Cycles) != 1 || d.Cycles[0].Amount != 1 || len(d.Cycles[0].Edges) != 2 {
+		t.Errorf("got cycles %+v, want 1 cycle 0->1->0 of amount 1", d.Cycles)
+	}
+}
+
+func TestDecomposeFlowSinkToSource(t *testing.T) {
+	G := graph.NewFlowNetwork(2)
+	G.AddEdge(*graph.NewFlowEdge(1, 0, 1)).AddResidualTo(0, 1)
+
+	if _, err := DecomposeFlow(G, 0, 1); !errors.Is(err, graph.ErrUnconservedFlow) {
+		t.Errorf("got error %v, want ErrUnconservedFlow", err)
+	}
+}
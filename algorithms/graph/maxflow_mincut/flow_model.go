@@ -0,0 +1,223 @@
+/* API: Flow Model */
+
+package maxflow
+
+import (
+	graph "azure/data_structures/flow_network"
+	"fmt"
+)
+
+type modelEdge struct {
+	from, to     int
+	lower, upper int
+}
+
+/* Source or sink with the amount it supplies or absorbs at most. */
+type terminal struct {
+	vertex, amount int
+}
+
+type FlowModel struct {
+	V       int
+	edges   []modelEdge
+	vcap    []int // vertex -> throughput limit
+	sources []terminal
+	sinks   []terminal
+}
+
+type FlowSolution struct {
+	Value       int
+	Flows       []int // edge ID -> flow
+	SourceFlows []int // AddSource() order -> supplied flow
+	SinkFlows   []int // AddSink() order -> absorbed flow
+}
+
+/* Edges, vertices & lower bounds that block a feasible flow. */
+type InfeasibleFlowError struct {
+	Shortfall int   // Lower-bound flow left unrouted
+	Vertices  []int // Source side of the blocking cut
+	Edges     []int // Saturated edge IDs crossing the cut
+	Throttled []int // Saturated vertices crossing the cut
+}
+
+func (err *InfeasibleFlowError) Error() string {
+	return fmt.Sprintf(
+		"flow model: lower bounds infeasible, %d units unrouted", err.Shortfall,
+	)
+}
+
+/* Create a Flow Model with V vertices. */
+func NewFlowModel(V int) *FlowModel {
+	if V < 0 {
+		panic("negative number of vertices")
+	}
+
+	vcap := make([]int, V)
+	for v := range V {
+		vcap[v] = INF
+	}
+
+	return &FlowModel{V: V, vcap: vcap}
+}
+
+/* Add an edge carrying between lower & upper units, return its ID. */
+func (M *FlowModel) AddEdge(v, w, lower, upper int) int {
+	M.IsVertexOf(v)
+	M.IsVertexOf(w)
+
+	if lower < 0 || lower > upper {
+		panic("invalid edge bounds")
+	}
+
+	M.edges = append(M.edges, modelEdge{v, w, lower, upper})
+	return len(M.edges) - 1
+}
+
+/* Limit the amount of flow passing through a vertex. */
+func (M *FlowModel) SetVertexCapacity(v, cap int) {
+	M.IsVertexOf(v)
+
+	if cap < 0 {
+		panic("negative vertex capacity")
+	}
+
+	M.vcap[v] = cap
+}
+
+/* Mark a vertex as a source supplying at most 'supply' units. */
+func (M *FlowModel) AddSource(v, supply int) {
+	M.IsVertexOf(v)
+
+	if supply < 0 {
+		panic("negative supply")
+	}
+
+	M.sources = append(M.sources, terminal{v, supply})
+}
+
+/* Mark a vertex as a sink absorbing at most 'demand' units. */
+func (M *FlowModel) AddSink(v, demand int) {
+	M.IsVertexOf(v)
+
+	if demand < 0 {
+		panic("negative demand")
+	}
+
+	M.sinks = append(M.sinks, terminal{v, demand})
+}
+
+/*
+Maxflow of the model reduced onto a single s-t Flow Network.
+- Time: O(E^2.V) & Space: O(E + V).
+*/
+func (M *FlowModel) Solve() (*FlowSolution, error) {
+	// Split capped vertices into (in -> out) pairs.
+	out := make([]int, M.V)
+	N := M.V
+	for v := range M.V {
+		out[v] = v
+		if M.vcap[v] != INF {
+			out[v] = N
+			N++
+		}
+	}
+
+	// Super source & sink, then lower-bound source & sink.
+	S, T, LS, LT := N, N+1, N+2, N+3
+	G := graph.NewFlowNetwork(N + 4)
+	excess := make([]int, N+4)
+
+	splits := make([]*graph.FlowEdge, M.V)
+	for v := range M.V {
+		if out[v] != v {
+			splits[v] = G.AddEdge(*graph.NewFlowEdge(v, out[v], M.vcap[v]))
+		}
+	}
+
+	// Keep lower bounds aside, route only the slack.
+	edges := make([]*graph.FlowEdge, len(M.edges))
+	for i, e := range M.edges {
+		edges[i] = G.AddEdge(*graph.NewFlowEdge(out[e.from], e.to, e.upper-e.lower))
+		excess[e.to] += e.lower
+		excess[out[e.from]] -= e.lower
+	}
+
+	sources := make([]*graph.FlowEdge, len(M.sources))
+	for i, src := range M.sources {
+		sources[i] = G.AddEdge(*graph.NewFlowEdge(S, src.vertex, src.amount))
+	}
+
+	sinks := make([]*graph.FlowEdge, len(M.sinks))
+	for i, sink := range M.sinks {
+		sinks[i] = G.AddEdge(*graph.NewFlowEdge(out[sink.vertex], T, sink.amount))
+	}
+
+	// Returning edge turns the s-t flow into a circulation.
+	G.AddEdge(*graph.NewFlowEdge(T, S, INF))
+
+	required := 0
+	for v := range N + 2 {
+		if excess[v] > 0 {
+			G.AddEdge(*graph.NewFlowEdge(LS, v, excess[v]))
+			required += excess[v]
+		} else if excess[v] < 0 {
+			G.AddEdge(*graph.NewFlowEdge(v, LT, -excess[v]))
+		}
+	}
+
+	// Phase 1: satisfy all lower bounds.
+	feasible := EdmondsKarpMaxFlow(G, LS, LT)
+	if feasible.Capacity < required {
+		reach := ResidualReachable(G, LS)
+		err := &InfeasibleFlowError{Shortfall: required - feasible.Capacity}
+
+		for v := range M.V {
+			if reach[v] {
+				err.Vertices = append(err.Vertices, v)
+			}
+
+			if splits[v] != nil && reach[v] && !reach[out[v]] {
+				err.Throttled = append(err.Throttled, v)
+			}
+		}
+
+		for i, e := range M.edges {
+			if reach[out[e.from]] && !reach[e.to] {
+				err.Edges = append(err.Edges, i)
+			}
+		}
+
+		return nil, err
+	}
+
+	// Phase 2: saturated LS/LT edges are dead ends -> plain s-t augment.
+	EdmondsKarpMaxFlow(G, S, T)
+
+	sol := &FlowSolution{
+		Flows:       make([]int, len(M.edges)),
+		SourceFlows: make([]int, len(M.sources)),
+		SinkFlows:   make([]int, len(M.sinks)),
+	}
+
+	for i, e := range edges {
+		sol.Flows[i] = M.edges[i].lower + e.Flow()
+	}
+
+	for i, e := range sources {
+		sol.SourceFlows[i] = e.Flow()
+		sol.Value += e.Flow()
+	}
+
+	for i, e := range sinks {
+		sol.SinkFlows[i] = e.Flow()
+	}
+
+	return sol, nil
+}
+
+/* Validate if a vertex belongs to a Flow Model. */
+func (M *FlowModel) IsVertexOf(v int) {
+	if v < 0 || v >= M.V {
+		panic("vertex out of bounds")
+	}
+}
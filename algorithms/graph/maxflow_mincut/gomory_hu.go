@@ -0,0 +1,184 @@
+/* Algorithm: Gomory-Hu Tree */
+
+package maxflow
+
+import (
+	flownet "azure/data_structures/flow_network"
+	"azure/data_structures/graph"
+	stackqueue "azure/data_structures/stack_queue"
+	"iter"
+)
+
+type GomoryHuTree struct {
+	V      int
+	Parent []int // v -> tree parent (root 0 has -1)
+	Weight []int // v -> mincut between v & Parent[v]
+
+	children [][]int
+	depth    []int
+}
+
+/*
+Gomory-Hu Cut Tree of an Undirected Graph (Gusfield).
+- Time: O(V^2.E^2) & Space: O(E + V).
+*/
+func NewGomoryHuTree(G *graph.Graph) *GomoryHuTree {
+	T := &GomoryHuTree{
+		V:      G.V,
+		Parent: make([]int, G.V),
+		Weight: make([]int, G.V),
+
+		children: make([][]int, G.V),
+		depth:    make([]int, G.V),
+	}
+
+	if G.V == 0 {
+		return T
+	}
+
+	for v := 1; v < G.V; v++ {
+		T.Parent[v] = 0
+	}
+
+	T.Parent[0] = -1
+
+	for s := 1; s < G.V; s++ {
+		t := T.Parent[s]
+
+		// Undirected edge ~ 2 opposite flow edges.
+		N := flownet.NewFlowNetwork(G.V)
+		for e := range G.Edges() {
+			v := e.Head()
+			w := e.Other(v)
+			N.AddEdge(*flownet.NewFlowEdge(v, w, e.Weight()))
+			N.AddEdge(*flownet.NewFlowEdge(w, v, e.Weight()))
+		}
+
+		flow := EdmondsKarpMaxFlow(N, s, t).Capacity
+		side := ResidualReachable(N, s)
+		T.Weight[s] = flow
+
+		// Vertices on s-side now hang below s.
+		for v := range G.V {
+			if v != s && side[v] && T.Parent[v] == t {
+				T.Parent[v] = s
+			}
+		}
+
+		// t's parent fell on s-side -> s takes t's place.
+		if T.Parent[t] != -1 && side[T.Parent[t]] {
+			T.Parent[s] = T.Parent[t]
+			T.Parent[t] = s
+			T.Weight[s] = T.Weight[t]
+			T.Weight[t] = flow
+		}
+	}
+
+	for v := range G.V {
+		if T.Parent[v] != -1 {
+			T.children[T.Parent[v]] = append(T.children[T.Parent[v]], v)
+		}
+	}
+
+	// Depths from the root downwards.
+	for v := range T.subtree(0) {
+		if v != 0 {
+			T.depth[v] = T.depth[T.Parent[v]] + 1
+		}
+	}
+
+	return T
+}
+
+/* Mincut value between 2 vertices: lightest edge on their tree path. */
+func (T *GomoryHuTree) MinCut(u, v int) int {
+	cut := T.lightestOnPath(u, v)
+	if cut == -1 {
+		return INF
+	}
+
+	return T.Weight[cut]
+}
+
+/* Shore of the mincut between 2 vertices that contains u. */
+func (T *GomoryHuTree) CutOf(u, v int) []bool {
+	cut := T.lightestOnPath(u, v)
+	side := make([]bool, T.V)
+
+	if cut == -1 {
+		for x := range T.V {
+			side[x] = true
+		}
+
+		return side
+	}
+
+	// Subtree below the lightest edge.
+	for x := range T.subtree(cut) {
+		side[x] = true
+	}
+
+	if !side[u] {
+		for x := range T.V {
+			side[x] = !side[x]
+		}
+	}
+
+	return side
+}
+
+/* Child endpoint of the lightest tree edge between u & v. */
+func (T *GomoryHuTree) lightestOnPath(u, v int) int {
+	T.IsVertexOf(u)
+	T.IsVertexOf(v)
+
+	cut := -1
+	relax := func(x int) {
+		if cut == -1 || T.Weight[x] < T.Weight[cut] {
+			cut = x
+		}
+	}
+
+	// Climb the deeper endpoint until both meet.
+	for u != v {
+		if T.depth[u] < T.depth[v] {
+			u, v = v, u
+		}
+
+		relax(u)
+		u = T.Parent[u]
+	}
+
+	return cut
+}
+
+/* Preorder of the tree rooted at a vertex. */
+func (T *GomoryHuTree) subtree(root int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		stack := stackqueue.NewStack[int](T.V)
+		stack.Push(root)
+
+		for !stack.IsEmpty() {
+			v, ok := stack.Pop()
+
+			if !ok {
+				panic("attempt to pop an empty Stack")
+			}
+
+			if !yield(v) {
+				return
+			}
+
+			for _, c := range T.children[v] {
+				stack.Push(c)
+			}
+		}
+	}
+}
+
+/* Validate if a vertex belongs to a Gomory-Hu Tree. */
+func (T *GomoryHuTree) IsVertexOf(v int) {
+	if v < 0 || v >= T.V {
+		panic("vertex out of bounds")
+	}
+}
@@ -0,0 +1,12 @@
+/* API: Min-cost Flow */
+
+package maxflow
+
+import graph "azure/data_structures/flow_network"
+
+type MinCostFlow struct {
+	Edges []*graph.FlowEdge
+	Flows []int // Edges[i] -> final flow
+	Value int
+	Cost  int
+}
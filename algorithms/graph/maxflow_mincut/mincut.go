@@ -4,6 +4,7 @@ package maxflow
 
 import (
 	graph "azure/data_structures/flow_network"
+	stackqueue "azure/data_structures/stack_queue"
 	"math"
 )
 
@@ -13,3 +14,29 @@ type MinCut struct {
 	Edges    []*graph.FlowEdge
 	Capacity int
 }
+
+/* Source side of the mincut: vertices reachable in residual network. */
+func ResidualReachable(G *graph.FlowNetwork, s int) []bool {
+	marked := make([]bool, G.V)
+	queue := stackqueue.NewQueue[int](G.V)
+	queue.Enqueue(s)
+	marked[s] = true
+
+	for !queue.IsEmpty() {
+		v, ok := queue.Dequeue()
+
+		if !ok {
+			panic("attempt to dequeue an empty Queue")
+		}
+
+		for e := range G.Adjacent(v) {
+			w := e.Other(v)
+			if !marked[w] && e.ResidualCapacityTo(w) > 0 {
+				marked[w] = true
+				queue.Enqueue(w)
+			}
+		}
+	}
+
+	return marked
+}
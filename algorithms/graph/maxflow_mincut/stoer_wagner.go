@@ -0,0 +1,93 @@
+/* Algorithm: Stoer-Wagner */
+
+package maxflow
+
+import "azure/data_structures/graph"
+
+type GlobalCut struct {
+	Weight int
+	Side   []bool // vertex -> on the cut-off shore
+}
+
+/*
+Global Mincut of an Undirected Graph (no fixed s & t).
+- Time: O(V^3) & Space: O(V^2).
+*/
+func StoerWagnerMinCut(G *graph.Graph) *GlobalCut {
+	V := G.V
+	if V < 2 {
+		panic("global mincut needs at least 2 vertices")
+	}
+
+	// Parallel edges add up, self-loops never cross a cut.
+	weight := make([][]int, V)
+	for v := range V {
+		weight[v] = make([]int, V)
+	}
+
+	for e := range G.Edges() {
+		v := e.Head()
+		w := e.Other(v)
+		if v != w {
+			weight[v][w] += e.Weight()
+			weight[w][v] += e.Weight()
+		}
+	}
+
+	// Original vertices contracted into each super vertex.
+	members := make([][]int, V)
+	for v := range V {
+		members[v] = []int{v}
+	}
+
+	merged := make([]bool, V)
+	best := &GlobalCut{Weight: INF}
+	var bestShore []int
+
+	for phase := range V - 1 {
+		added := make([]bool, V)
+		conn := make([]int, V)
+		prev, last := -1, -1
+
+		// Maximum adjacency order: add the most tightly connected.
+		for range V - phase {
+			sel := -1
+			for v := range V {
+				if !merged[v] && !added[v] && (sel == -1 || conn[v] > conn[sel]) {
+					sel = v
+				}
+			}
+
+			added[sel] = true
+			prev, last = last, sel
+
+			for v := range V {
+				if !merged[v] && !added[v] {
+					conn[v] += weight[sel][v]
+				}
+			}
+		}
+
+		// Cut-of-the-phase separates the last vertex from the rest.
+		if conn[last] < best.Weight {
+			best.Weight = conn[last]
+			bestShore = append(bestShore[:0], members[last]...)
+		}
+
+		// Contract the last 2 vertices of the order.
+		members[prev] = append(members[prev], members[last]...)
+		for v := range V {
+			weight[prev][v] += weight[last][v]
+			weight[v][prev] = weight[prev][v]
+		}
+
+		merged[last] = true
+	}
+
+	best.Side = make([]bool, V)
+	for _, v := range bestShore {
+		best.Side[v] = true
+	}
+
+	return best
+}
@@ -0,0 +1,262 @@
+/* Algorithm: Successive Shortest Path */
+
+package maxflow
+
+import (
+	graph "azure/data_structures/flow_network"
+	pq "azure/data_structures/priority_queue"
+	stackqueue "azure/data_structures/stack_queue"
+)
+
+/*
+Min-cost Maxflow using Dijkstra over Johnson potentials. Existing flow is
+discarded. Negative costs are allowed, even on cycles: those are cancelled
+by a min-cost circulation before any s-t flow is pushed.
+- Time: O(F.E.logV) & Space: O(E + V).
+*/
+func MinCostMaxFlow(G *graph.FlowNetwork, s, t int) *MinCostFlow {
+	G.IsVertexOf(s)
+	G.IsVertexOf(t)
+
+	if s == t {
+		panic("source and sink coincide")
+	}
+
+	// Augmenting from a min-cost circulation keeps every flow value min-cost.
+	R, baseCost := newCirculation(G)
+	value, cost := R.augment(s, t)
+
+	return R.readback(value, baseCost+cost)
+}
+
+/*
+Min-cost Circulation (all vertices conserve flow). Existing flow is discarded.
+- Time: O(F.E.logV) & Space: O(E + V).
+*/
+func MinCostCirculation(G *graph.FlowNetwork) *MinCostFlow {
+	R, cost := newCirculation(G)
+	return R.readback(0, cost)
+}
+
+/* Residual network holding a min-cost circulation, with its cost. */
+func newCirculation(G *graph.FlowNetwork) (*residualNetwork, int) {
+	// Super source & sink absorb the imbalance.
+	R := newResidualNetwork(G, G.V+2)
+	S, T := G.V, G.V+1
+
+	excess := make([]int, G.V)
+	baseCost := 0
+
+	// Saturate negative-cost edges: their residual turn non-negative.
+	for i, e := range R.edges {
+		if e.Cost() < 0 {
+			v := e.Head()
+			w := e.Other(v)
+			R.cap[2*i], R.cap[2*i+1] = 0, e.Capacity()
+			excess[w] += e.Capacity()
+			excess[v] -= e.Capacity()
+			baseCost += e.Cost() * e.Capacity()
+		}
+	}
+
+	for v := range G.V {
+		if excess[v] > 0 {
+			R.addArc(S, v, excess[v], 0)
+		} else if excess[v] < 0 {
+			R.addArc(v, T, -excess[v], 0)
+		}
+	}
+
+	// Rebalance the saturated flow in the cheapest way. Afterwards S has
+	// no residual arc out & T none in, so later augmentations avoid both.
+	_, cost := R.augment(S, T)
+
+	return R, baseCost + cost
+}
+
+/* Residual arcs: 2i is forward & 2i+1 is backward of edge i. */
+type residualNetwork struct {
+	edges []*graph.FlowEdge
+	adj   [][]int
+	to    []int
+	cap   []int
+	cost  []int
+	pot   []int
+}
+
+func newResidualNetwork(G *graph.FlowNetwork, V int) *residualNetwork {
+	R := &residualNetwork{
+		adj: make([][]int, V),
+		pot: make([]int, V),
+	}
+
+	for e := range G.Edges() {
+		v := e.Head()
+		R.edges = append(R.edges, e)
+		R.addArc(v, e.Other(v), e.Capacity(), e.Cost())
+	}
+
+	return R
+}
+
+func (R *residualNetwork) addArc(v, w, cap, cost int) {
+	R.adj[v] = append(R.adj[v], len(R.to))
+	R.to = append(R.to, w)
+	R.cap = append(R.cap, cap)
+	R.cost = append(R.cost, cost)
+
+	R.adj[w] = append(R.adj[w], len(R.to))
+	R.to = append(R.to, v)
+	R.cap = append(R.cap, 0)
+	R.cost = append(R.cost, -cost)
+}
+
+/* Push as much flow as possible from s to t along cheapest paths. */
+func (R *residualNetwork) augment(s, t int) (int, int) {
+	V := len(R.adj)
+	R.initPotentials()
+
+	distTo := make([]int, V)
+	arcTo := make([]int, V)
+	value, cost := 0, 0
+
+	for R.shortestPath(s, t, distTo, arcTo) {
+		// Johnson: keep reduced costs non-negative.
+		for v := range V {
+			if distTo[v] != INF {
+				R.pot[v] += distTo[v]
+			}
+		}
+
+		bottleneck := INF
+		for v := t; v != s; v = R.to[arcTo[v]^1] {
+			bottleneck = min(bottleneck, R.cap[arcTo[v]])
+		}
+
+		for v := t; v != s; v = R.to[arcTo[v]^1] {
+			a := arcTo[v]
+			R.cap[a] -= bottleneck
+			R.cap[a^1] += bottleneck
+			cost += bottleneck * R.cost[a]
+		}
+
+		value += bottleneck
+	}
+
+	return value, cost
+}
+
+/*
+Bellman-Ford potentials, so that negative costs are allowed. Every caller
+starts from a residual network without negative-cost cycles.
+*/
+func (R *residualNetwork) initPotentials() {
+	V := len(R.adj)
+
+	// Virtual source: every vertex starts at distance 0.
+	queue := stackqueue.NewQueue[int](V)
+	onQueue := make([]bool, V)
+	relaxCount := make([]int, V)
+	for v := range V {
+		R.pot[v] = 0
+		queue.Enqueue(v)
+		onQueue[v] = true
+	}
+
+	for !queue.IsEmpty() {
+		v, ok := queue.Dequeue()
+
+		if !ok {
+			panic("attempt to dequeue an empty Queue")
+		}
+
+		onQueue[v] = false
+
+		for _, a := range R.adj[v] {
+			w := R.to[a]
+			if R.cap[a] > 0 && R.pot[v]+R.cost[a] < R.pot[w] {
+				R.pot[w] = R.pot[v] + R.cost[a]
+
+				if !onQueue[w] {
+					queue.Enqueue(w)
+					onQueue[w] = true
+					relaxCount[w]++
+
+					if relaxCount[w] > V {
+						panic("negative-cost cycle in residual network")
+					}
+				}
+			}
+		}
+	}
+}
+
+/* Dijkstra on reduced costs of residual arcs. */
+func (R *residualNetwork) shortestPath(s, t int, distTo, arcTo []int) bool {
+	V := len(R.adj)
+	for v := range V {
+		distTo[v] = INF
+		arcTo[v] = -1
+	}
+
+	distTo[s] = 0
+	minpq := pq.NewIndexPQ(V, func(a, b int) bool { return a < b })
+	minpq.Enqueue(s, 0)
+
+	for !minpq.IsEmpty() {
+		v, dist, ok := minpq.Dequeue()
+
+		if !ok {
+			panic("attempt to dequeue an empty PQ")
+		}
+
+		for _, a := range R.adj[v] {
+			if R.cap[a] == 0 {
+				continue
+			}
+
+			w := R.to[a]
+			newDist := dist + R.cost[a] + R.pot[v] - R.pot[w]
+			if newDist < distTo[w] {
+				distTo[w] = newDist // Relax arc
+				arcTo[w] = a
+
+				if minpq.Contains(w) {
+					minpq.ChangeKey(w, newDist) // UPDATE
+				} else {
+					minpq.Enqueue(w, newDist) // QUERY
+				}
+			}
+		}
+	}
+
+	return distTo[t] != INF
+}
+
+/* Overwrite the original Flow Edges with the final flows. */
+func (R *residualNetwork) readback(value, cost int) *MinCostFlow {
+	res := &MinCostFlow{
+		Edges: R.edges,
+		Flows: make([]int, len(R.edges)),
+		Value: value,
+		Cost:  cost,
+	}
+
+	for i, e := range R.edges {
+		flow := R.cap[2*i+1]
+		res.Flows[i] = flow
+		setFlow(e, flow)
+	}
+
+	return res
+}
+
+/* Move a Flow Edge's flow to an exact amount. */
+func setFlow(e *graph.FlowEdge, flow int) {
+	v := e.Head()
+	if w := e.Other(v); w != v {
+		e.AddResidualTo(w, flow-e.Flow())
+	} else {
+		e.AddResidualTo(v, e.Flow()-flow) // Self-loop only matches its tail
+	}
+}
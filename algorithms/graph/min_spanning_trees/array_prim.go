@@ -5,23 +5,62 @@ package mst
 import "azure/data_structures/graph"
 
 /*
-Array-variant Prim's Minimum Spanning Tree on Undirected Graph.
+Array-variant Prim's Minimum Spanning Tree of src's component on Undirected Graph.
 - Time: O(V^2) & Space: O(V).
 */
 func ArrayPrimMST(G *graph.Graph, src int) *MST {
-	// Assume T is the greedily growing tree.
-	mst := &MST{
-		EdgeTo: make([]graph.Edge, G.V),
+	return ArrayPrimMSTFunc(G, src, edgeWeight)
+}
+
+/*
+Array-variant Prim's Minimum Spanning Tree with weights drawn from each edge by weight.
+- Time: O(V^2) & Space: O(V).
+*/
+func ArrayPrimMSTFunc[VP, EP any](
+	G *graph.GraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+) *MSTOf[EP] {
+	mst := &MSTOf[EP]{
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
 		Weight: 0,
 	}
 
+	arrayPrim(G, src, weight, make([]bool, G.V), newDistTo(G.V), mst)
+	return mst
+}
+
+/*
+Array-variant Prim's Minimum Spanning Forest, regrowing from each unreached vertex.
+- Time: O(V^2) & Space: O(V).
+*/
+func ArrayPrimMSF(G *graph.Graph) *MSF {
+	return ArrayPrimMSFFunc(G, edgeWeight)
+}
+
+/*
+Array-variant Prim's Minimum Spanning Forest with weights drawn from each edge by weight.
+- Time: O(V^2) & Space: O(V).
+*/
+func ArrayPrimMSFFunc[VP, EP any](
+	G *graph.GraphOf[VP, EP], weight func(graph.EdgeOf[EP]) int,
+) *MSFOf[EP] {
+	forest := &MSTOf[EP]{EdgeTo: make([]graph.EdgeOf[EP], G.V)}
 	marked := make([]bool, G.V)
-	
-	distTo := make([]int, G.V)
+	distTo := newDistTo(G.V)
+
 	for v := range G.V {
-		distTo[v] = INF
+		if !marked[v] {
+			arrayPrim(G, v, weight, marked, distTo, forest)
+		}
 	}
 
+	return newMSF(G.V, forest.Edges(), weight)
+}
+
+/* Grow the tree T of src into mst, marking every vertex it reaches. */
+func arrayPrim[VP, EP any](
+	G *graph.GraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+	marked []bool, distTo []int, mst *MSTOf[EP],
+) {
 	distTo[src] = 0
 
 	for {
@@ -48,12 +87,10 @@ func ArrayPrimMST(G *graph.Graph, src int) *MST {
 		// Distance update to all non-tree adjacent vertices.
 		for e := range G.Adjacent(minV) {
 			w := e.Other(minV)
-			if !marked[w] && e.Weight() < distTo[w] {
-				distTo[w] = e.Weight()
+			if cost := weight(e); !marked[w] && cost < distTo[w] {
+				distTo[w] = cost
 				mst.EdgeTo[w] = e
 			}
 		}
 	}
-
-	return mst
 }
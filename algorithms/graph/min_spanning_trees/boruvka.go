@@ -0,0 +1,187 @@
+/* Algorithm: Boruvka */
+
+package mst
+
+import (
+	"azure/data_structures/graph"
+	stackqueue "azure/data_structures/stack_queue"
+	"cmp"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+/*
+Boruvka's Minimum Spanning Forest, scanning components in parallel.
+- Time: O(E.logV) & Space: O(E + V).
+*/
+func BoruvkaMSF(G *graph.Graph, workers int) *MSF {
+	return BoruvkaMSFFunc(G, workers, edgeWeight)
+}
+
+/*
+Boruvka's Minimum Spanning Forest with weights drawn from each edge by weight.
+- Time: O(E.logV) & Space: O(E + V).
+*/
+func BoruvkaMSFFunc[VP, EP any](
+	G *graph.GraphOf[VP, EP], workers int, weight func(graph.EdgeOf[EP]) int,
+) *MSFOf[EP] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	var forest []graph.EdgeOf[EP]
+	comp := make([]int, G.V) // vertex -> current component
+	for v := range G.V {
+		comp[v] = v
+	}
+
+	adj := make([][]int, G.V) // forest adjacency
+
+	// Cheapest edge per component, shared by all workers.
+	cheapest := make([]candidate[EP], G.V)
+	locks := make([]sync.Mutex, G.V)
+
+	for {
+		clear(cheapest)
+		chunk := (G.V + workers - 1) / workers
+
+		var wg sync.WaitGroup
+		for i := range workers {
+			lo, hi := i*chunk, min((i+1)*chunk, G.V)
+			if lo >= hi {
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for v := lo; v < hi; v++ {
+					// Settle the vertex locally, lock its component once.
+					var best candidate[EP]
+					for e := range G.Adjacent(v) {
+						if comp[v] != comp[e.Other(v)] {
+							best.offer(e, weight)
+						}
+					}
+
+					if best.ok {
+						locks[comp[v]].Lock()
+						cheapest[comp[v]].offer(best.e, weight)
+						locks[comp[v]].Unlock()
+					}
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		var picked []graph.EdgeOf[EP]
+		for c := range cheapest {
+			if cheapest[c].ok {
+				picked = append(picked, cheapest[c].e)
+			}
+		}
+
+		// No crossing edge left -> Every tree is spanning.
+		if len(picked) == 0 {
+			break
+		}
+
+		// 2 components may pick the same edge.
+		slices.SortFunc(picked, func(a, b graph.EdgeOf[EP]) int {
+			return compareEdges(a, b, weight)
+		})
+		picked = slices.CompactFunc(picked, func(a, b graph.EdgeOf[EP]) bool {
+			return compareEdges(a, b, weight) == 0
+		})
+
+		for _, e := range picked {
+			v := e.Head()
+			w := e.Other(v)
+			adj[v] = append(adj[v], w)
+			adj[w] = append(adj[w], v)
+			forest = append(forest, e)
+		}
+
+		if relabel(adj, comp) == 1 {
+			break
+		}
+	}
+
+	return newMSF(G.V, forest, weight)
+}
+
+type candidate[P any] struct {
+	e  graph.EdgeOf[P]
+	ok bool
+}
+
+/* Keep the smaller edge under a total order. */
+func (c *candidate[P]) offer(e graph.EdgeOf[P], weight func(graph.EdgeOf[P]) int) {
+	if !c.ok || compareEdges(e, c.e, weight) < 0 {
+		c.e = e
+		c.ok = true
+	}
+}
+
+/* Weight first, then endpoints: ties never close a cycle. */
+func compareEdges[P any](a, b graph.EdgeOf[P], weight func(graph.EdgeOf[P]) int) int {
+	if wa, wb := weight(a), weight(b); wa != wb {
+		return cmp.Compare(wa, wb)
+	}
+
+	av, aw := a.Head(), a.Other(a.Head())
+	bv, bw := b.Head(), b.Other(b.Head())
+	if av > aw {
+		av, aw = aw, av
+	}
+
+	if bv > bw {
+		bv, bw = bw, bv
+	}
+
+	if av != bv {
+		return cmp.Compare(av, bv)
+	}
+
+	return cmp.Compare(aw, bw)
+}
+
+/* Relabel components over forest edges, return their count. */
+func relabel(adj [][]int, comp []int) int {
+	for v := range comp {
+		comp[v] = -1
+	}
+
+	count := 0
+	stack := stackqueue.NewStack[int](len(comp))
+	for s := range comp {
+		if comp[s] != -1 {
+			continue
+		}
+
+		comp[s] = count
+		stack.Push(s)
+
+		for !stack.IsEmpty() {
+			v, ok := stack.Pop()
+
+			if !ok {
+				panic("attempt to pop an empty Stack")
+			}
+
+			for _, w := range adj[v] {
+				if comp[w] == -1 {
+					comp[w] = count
+					stack.Push(w)
+				}
+			}
+		}
+
+		count++
+	}
+
+	return count
+}
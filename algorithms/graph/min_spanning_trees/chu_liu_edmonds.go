@@ -0,0 +1,185 @@
+/* Algorithm: Chu-Liu/Edmonds (Tarjan) */
+
+package mst
+
+import (
+	"azure/data_structures/graph"
+	unionfind "azure/data_structures/union_find"
+	"errors"
+)
+
+var ErrUnreachable = errors.New("mst: root cannot reach every vertex")
+
+type Arborescence struct {
+	EdgeTo []graph.Edge // v -> incoming edge (unset for Root)
+	Weight int
+	Root   int
+}
+
+/*
+Minimum Spanning Arborescence of a Digraph rooted at 'root'.
+- Time: O(E.logV) & Space: O(E + V).
+*/
+func ChuLiuEdmondsMSA(G *graph.Digraph, root int) (*Arborescence, error) {
+	G.IsVertexOf(root)
+
+	// Incoming edges of each (super) vertex in a mergeable heap.
+	var edges []graph.Edge
+	heaps := make([]*skewNode, G.V)
+	for e := range G.Edges() {
+		v := e.Head()
+		w := e.Other(v)
+		if v != w { // Self-loop is never used
+			heaps[w] = mergeSkew(heaps[w], &skewNode{weight: e.Weight(), id: len(edges)})
+			edges = append(edges, e)
+		}
+	}
+
+	type contraction struct {
+		vertex, snapshot int
+		cycle            []int
+	}
+
+	uf := unionfind.NewRollbackUnionFind[int]()
+	for v := range G.V {
+		uf.Add(v)
+	}
+
+	seen := make([]int, G.V)
+	for v := range G.V {
+		seen[v] = -1
+	}
+
+	seen[root] = root
+	chosen := make([]int, G.V) // edge IDs picked on current walk
+	path := make([]int, G.V)   // super vertices on current walk
+	in := make([]int, G.V)     // super vertex -> incoming edge ID
+	var cycles []contraction
+	weight := 0
+
+	for s := range G.V {
+		u, qi := s, 0
+
+		// Walk backwards along cheapest incoming edges.
+		for seen[u] < 0 {
+			if heaps[u] == nil {
+				return nil, ErrUnreachable
+			}
+
+			top := heaps[u].top()
+			heaps[u].delta -= top.weight // Reduced costs
+			heaps[u] = heaps[u].pop()
+
+			chosen[qi], path[qi] = top.id, u
+			qi++
+			seen[u] = s
+			weight += top.weight
+			u = uf.Find(edges[top.id].Head())
+
+			// Walk bites its own tail -> Contract the cycle.
+			if seen[u] == s {
+				var merged *skewNode
+				end, snapshot := qi, uf.Snapshot()
+
+				for {
+					qi--
+					w := path[qi]
+					merged = mergeSkew(merged, heaps[w])
+					if !uf.Union(u, w) {
+						break
+					}
+				}
+
+				u = uf.Find(u)
+				heaps[u], seen[u] = merged, -1
+				cycles = append(cycles, contraction{
+					u, snapshot, append([]int(nil), chosen[qi:end]...),
+				})
+			}
+		}
+
+		for i := range qi {
+			e := edges[chosen[i]]
+			in[uf.Find(e.Other(e.Head()))] = chosen[i]
+		}
+	}
+
+	// Expand latest contraction first: keep all but the entering edge.
+	for i := len(cycles) - 1; i >= 0; i-- {
+		c := cycles[i]
+		uf.Rollback(c.snapshot)
+
+		entering := in[c.vertex]
+		for _, id := range c.cycle {
+			e := edges[id]
+			in[uf.Find(e.Other(e.Head()))] = id
+		}
+
+		e := edges[entering]
+		in[uf.Find(e.Other(e.Head()))] = entering
+	}
+
+	arb := &Arborescence{
+		EdgeTo: make([]graph.Edge, G.V),
+		Weight: weight,
+		Root:   root,
+	}
+
+	for v := range G.V {
+		if v != root {
+			arb.EdgeTo[v] = edges[in[v]]
+		}
+	}
+
+	return arb, nil
+}
+
+/* Skew heap node with lazy weight offsets. */
+type skewNode struct {
+	weight, delta int
+	id            int
+	left, right   *skewNode
+}
+
+func (n *skewNode) push() {
+	n.weight += n.delta
+	if n.left != nil {
+		n.left.delta += n.delta
+	}
+
+	if n.right != nil {
+		n.right.delta += n.delta
+	}
+
+	n.delta = 0
+}
+
+func (n *skewNode) top() skewNode {
+	n.push()
+	return *n
+}
+
+func (n *skewNode) pop() *skewNode {
+	n.push()
+	return mergeSkew(n.left, n.right)
+}
+
+func mergeSkew(a, b *skewNode) *skewNode {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	a.push()
+	b.push()
+
+	if a.weight > b.weight {
+		a, b = b, a
+	}
+
+	a.left, a.right = mergeSkew(b, a.right), a.left
+	return a
+}
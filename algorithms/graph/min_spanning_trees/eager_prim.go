@@ -8,26 +8,66 @@ import (
 )
 
 /*
-Prim's Minimum Spanning Tree on Undirected Graph (Eager variant).
+Prim's Minimum Spanning Tree of src's component on Undirected Graph (Eager variant).
 - Time: O(E.logV) & Space: O(V).
 */
 func EagerPrimMST(G *graph.Graph, src int) *MST {
-	// Assume T is the greedily growing tree.
-	mst := &MST{
-		EdgeTo: make([]graph.Edge, G.V),
+	return EagerPrimMSTFunc(G, src, edgeWeight)
+}
+
+/*
+Eager Prim's Minimum Spanning Tree with weights drawn from each edge by weight.
+- Time: O(E.logV) & Space: O(V).
+*/
+func EagerPrimMSTFunc[VP, EP any](
+	G *graph.GraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+) *MSTOf[EP] {
+	mst := &MSTOf[EP]{
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
 		Weight: 0,
 	}
 
+	eagerPrim(G, src, weight, make([]bool, G.V), newDistTo(G.V), mst)
+	return mst
+}
+
+/*
+Eager Prim's Minimum Spanning Forest, regrowing from each unreached vertex.
+- Time: O(E.logV) & Space: O(V).
+*/
+func EagerPrimMSF(G *graph.Graph) *MSF {
+	return EagerPrimMSFFunc(G, edgeWeight)
+}
+
+/*
+Eager Prim's Minimum Spanning Forest with weights drawn from each edge by weight.
+- Time: O(E.logV) & Space: O(V).
+*/
+func EagerPrimMSFFunc[VP, EP any](
+	G *graph.GraphOf[VP, EP], weight func(graph.EdgeOf[EP]) int,
+) *MSFOf[EP] {
+	forest := &MSTOf[EP]{EdgeTo: make([]graph.EdgeOf[EP], G.V)}
 	marked := make([]bool, G.V)
-	minpq := pq.NewIndexPQ(G.V, func(a, b int) bool {
-		return a < b
-	})
+	distTo := newDistTo(G.V)
 
-	distTo := make([]int, G.V)
 	for v := range G.V {
-		distTo[v] = INF
+		if !marked[v] {
+			eagerPrim(G, v, weight, marked, distTo, forest)
+		}
 	}
 
+	return newMSF(G.V, forest.Edges(), weight)
+}
+
+/* Grow the tree T of src into mst, marking every vertex it reaches. */
+func eagerPrim[VP, EP any](
+	G *graph.GraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+	marked []bool, distTo []int, mst *MSTOf[EP],
+) {
+	minpq := pq.NewIndexPQ(G.V, func(a, b int) bool {
+		return a < b
+	})
+
 	distTo[src] = 0
 	minpq.Enqueue(src, distTo[src])
 
@@ -42,14 +82,14 @@ func EagerPrimMST(G *graph.Graph, src int) *MST {
 			}
 
 			// Minimum-weight incoming edge to each vertex.
-			if e.Weight() < distTo[w] {
-				distTo[w] = e.Weight()
+			if cost := weight(e); cost < distTo[w] {
+				distTo[w] = cost
 				mst.EdgeTo[w] = e
 
 				if minpq.Contains(w) {
-					minpq.ChangeKey(w, e.Weight()) // UPDATE
+					minpq.ChangeKey(w, cost) // UPDATE
 				} else {
-					minpq.Enqueue(w, e.Weight()) // QUERY
+					minpq.Enqueue(w, cost) // QUERY
 				}
 			}
 		}
@@ -57,15 +97,23 @@ func EagerPrimMST(G *graph.Graph, src int) *MST {
 
 	for !minpq.IsEmpty() {
 		// Add closest min-weight edge to T.
-		v, weight, ok := minpq.Dequeue()
+		v, cost, ok := minpq.Dequeue()
 
 		if !ok {
 			panic("attempt to dequeue an empty PQ")
 		}
 
-		mst.Weight += weight
+		mst.Weight += cost
 		scan(v)
 	}
+}
+
+/* Every vertex starts infinitely far from the tree. */
+func newDistTo(V int) []int {
+	distTo := make([]int, V)
+	for v := range V {
+		distTo[v] = INF
+	}
 
-	return mst
+	return distTo
 }
@@ -4,6 +4,7 @@ package mst
 
 import (
 	"azure/data_structures/graph"
+	unionfind "azure/data_structures/union_find"
 	"cmp"
 	"slices"
 )
@@ -13,63 +14,62 @@ Kruskal's Minimum Spanning Tree of an Undirected Graph.
 - Time: O(E.logE) & Space: O(E).
 */
 func KruskalMST(G *graph.Graph) *MST {
-	mst := &MST{
-		EdgeTo: make([]graph.Edge, G.V),
-		Weight: 0,
-	}
-
-	rank := make([]int, G.V)
-	parent := make([]int, G.V)
-	for i := range G.V {
-		parent[i] = i
-	}
+	return KruskalMSTFunc(G, edgeWeight)
+}
 
-	find := func(i int) int {
-		for i != parent[i] {
-			parent[i] = parent[parent[i]] // Half-way compression
-			i = parent[i]
-		}
+/*
+Kruskal's Minimum Spanning Tree with weights drawn from each edge by weight.
+- Time: O(E.logE) & Space: O(E).
+*/
+func KruskalMSTFunc[VP, EP any](
+	G *graph.GraphOf[VP, EP], weight func(graph.EdgeOf[EP]) int,
+) *MSTOf[EP] {
+	return newMST(G.V, kruskal(G, weight), weight)
+}
 
-		return parent[i]
-	}
+/*
+Kruskal's Minimum Spanning Forest of an Undirected Graph.
+- Time: O(E.logE) & Space: O(E).
+*/
+func KruskalMSF(G *graph.Graph) *MSF {
+	return KruskalMSFFunc(G, edgeWeight)
+}
 
-	union := func(x, y int) bool {
-		rx, ry := find(x), find(y)
-		if rx == ry {
-			return false
-		}
+/*
+Kruskal's Minimum Spanning Forest with weights drawn from each edge by weight.
+- Time: O(E.logE) & Space: O(E).
+*/
+func KruskalMSFFunc[VP, EP any](
+	G *graph.GraphOf[VP, EP], weight func(graph.EdgeOf[EP]) int,
+) *MSFOf[EP] {
+	return newMSF(G.V, kruskal(G, weight), weight)
+}
 
-		diff := rank[rx] - rank[ry] // Union by Rank
-		if diff < 0 {
-			parent[rx] = ry
-		} else if diff > 0 {
-			parent[ry] = rx
-		} else {
-			parent[ry] = rx
-			rank[ry]++
-		}
+/* Forest edges accepted by Kruskal, in ascending weights. */
+func kruskal[VP, EP any](
+	G *graph.GraphOf[VP, EP], weight func(graph.EdgeOf[EP]) int,
+) []graph.EdgeOf[EP] {
+	var forest []graph.EdgeOf[EP]
 
-		return true
-	}
+	uf := unionfind.NewIntUnionFind(G.V)
 
-	edges := make([]graph.Edge, 0, G.E)
+	edges := make([]graph.EdgeOf[EP], 0, G.E)
 	for e := range G.Edges() {
 		edges = append(edges, e)
 	}
 
 	// Ascending edge weights sorting.
-	slices.SortFunc(edges, func(a, b graph.Edge) int {
-		return cmp.Compare(a.Weight(), b.Weight())
+	slices.SortFunc(edges, func(a, b graph.EdgeOf[EP]) int {
+		return cmp.Compare(weight(a), weight(b))
 	})
 
 	for _, e := range edges {
 		v := e.Head()
 		w := e.Other(v)
-		if union(v, w) {
-			mst.EdgeTo[w] = e
-			mst.Weight += e.Weight()
+		if uf.Union(v, w) {
+			forest = append(forest, e)
 		}
 	}
 
-	return mst
+	return forest
 }
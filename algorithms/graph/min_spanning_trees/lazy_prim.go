@@ -8,19 +8,63 @@ import (
 )
 
 /*
-Prim's Minimum Spanning Tree on Undirected Graph (Lazy variant).
+Prim's Minimum Spanning Tree of src's component on Undirected Graph (Lazy variant).
 - Time: O(E.logE) & Space: O(E).
 */
 func LazyPrimMST(G *graph.Graph, src int) *MST {
-	// Assume T is the greedily growing tree.
-	mst := &MST{
-		EdgeTo: make([]graph.Edge, G.V),
+	return LazyPrimMSTFunc(G, src, edgeWeight)
+}
+
+/*
+Lazy Prim's Minimum Spanning Tree with weights drawn from each edge by weight.
+- Time: O(E.logE) & Space: O(E).
+*/
+func LazyPrimMSTFunc[VP, EP any](
+	G *graph.GraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+) *MSTOf[EP] {
+	mst := &MSTOf[EP]{
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
 		Weight: 0,
 	}
 
+	lazyPrim(G, src, weight, make([]bool, G.V), mst)
+	return mst
+}
+
+/*
+Lazy Prim's Minimum Spanning Forest, regrowing from each unreached vertex.
+- Time: O(E.logE) & Space: O(E + V).
+*/
+func LazyPrimMSF(G *graph.Graph) *MSF {
+	return LazyPrimMSFFunc(G, edgeWeight)
+}
+
+/*
+Lazy Prim's Minimum Spanning Forest with weights drawn from each edge by weight.
+- Time: O(E.logE) & Space: O(E + V).
+*/
+func LazyPrimMSFFunc[VP, EP any](
+	G *graph.GraphOf[VP, EP], weight func(graph.EdgeOf[EP]) int,
+) *MSFOf[EP] {
+	forest := &MSTOf[EP]{EdgeTo: make([]graph.EdgeOf[EP], G.V)}
 	marked := make([]bool, G.V)
-	minpq := pq.NewPQ(func(a, b graph.Edge) bool {
-		return a.Weight() < b.Weight()
+
+	for v := range G.V {
+		if !marked[v] {
+			lazyPrim(G, v, weight, marked, forest)
+		}
+	}
+
+	return newMSF(G.V, forest.Edges(), weight)
+}
+
+/* Grow the tree T of src into mst, marking every vertex it reaches. */
+func lazyPrim[VP, EP any](
+	G *graph.GraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+	marked []bool, mst *MSTOf[EP],
+) {
+	minpq := pq.NewPQ(func(a, b graph.EdgeOf[EP]) bool {
+		return weight(a) < weight(b)
 	})
 	
 	scan := func(v int) {
@@ -46,16 +90,14 @@ func LazyPrimMST(G *graph.Graph, src int) *MST {
 		}
 
 		// One edge's endpoint belongs to T.
+		if !marked[v] {
+			v, w = w, v
+		}
+
 		mst.EdgeTo[w] = e
-		mst.Weight += e.Weight()
+		mst.Weight += weight(e)
 
 		// Discover unmarked endpoint.
-		if !marked[v] {
-			scan(v)
-		} else {
-			scan(w)
-		}
+		scan(w)
 	}
-
-	return mst
 }
@@ -6,7 +6,127 @@ import "azure/data_structures/graph"
 
 const INF = 1<<63 - 1
 
-type MST struct {
-	EdgeTo []graph.Edge
+/* Default weight extractor: the edge's own weight. */
+func edgeWeight[P any](e graph.EdgeOf[P]) int {
+	return e.Weight()
+}
+
+/* Minimum spanning tree over edges with payloads of type P. */
+type MSTOf[P any] struct {
+	EdgeTo []graph.EdgeOf[P]
 	Weight int
 }
+
+type MST = MSTOf[struct{}]
+
+/* Tree edges of an MST, skipping unset EdgeTo entries. */
+func (mst *MSTOf[P]) Edges() []graph.EdgeOf[P] {
+	var edges []graph.EdgeOf[P]
+	for v, e := range mst.EdgeTo {
+		if edgeToParent(v, e) {
+			edges = append(edges, e)
+		}
+	}
+
+	return edges
+}
+
+/* Root each tree at its smallest vertex: EdgeTo[v] leads to parent. */
+func newMST[P any](V int, edges []graph.EdgeOf[P], weight func(graph.EdgeOf[P]) int) *MSTOf[P] {
+	mst := &MSTOf[P]{
+		EdgeTo: make([]graph.EdgeOf[P], V),
+		Weight: 0,
+	}
+
+	adj := make([][]graph.EdgeOf[P], V)
+	for _, e := range edges {
+		v := e.Head()
+		w := e.Other(v)
+		adj[v] = append(adj[v], e)
+		adj[w] = append(adj[w], e)
+		mst.Weight += weight(e)
+	}
+
+	marked := make([]bool, V)
+	var dfs func(int)
+	dfs = func(v int) {
+		marked[v] = true
+		for _, e := range adj[v] {
+			w := e.Other(v)
+			if !marked[w] {
+				mst.EdgeTo[w] = e
+				dfs(w)
+			}
+		}
+	}
+
+	for v := range V {
+		if !marked[v] {
+			dfs(v)
+		}
+	}
+
+	return mst
+}
+
+/* Check if an EdgeTo entry is a real edge linking v to its parent. */
+func edgeToParent[P any](v int, e graph.EdgeOf[P]) bool {
+	h := e.Head()
+	o := e.Other(h)
+	return h != o && (h == v || o == v)
+}
+
+/* Minimum spanning forest over edges with payloads of type P. */
+type MSFOf[P any] struct {
+	Trees     [][]graph.EdgeOf[P] // component -> its tree edges
+	Component []int               // vertex -> component ID
+	Weight    int
+}
+
+type MSF = MSFOf[struct{}]
+
+/* Group spanning forest edges into one tree per component. */
+func newMSF[P any](V int, edges []graph.EdgeOf[P], weight func(graph.EdgeOf[P]) int) *MSFOf[P] {
+	msf := &MSFOf[P]{
+		Component: make([]int, V),
+		Weight:    0,
+	}
+
+	adj := make([][]int, V)
+	for v := range V {
+		msf.Component[v] = -1
+	}
+
+	for _, e := range edges {
+		v := e.Head()
+		w := e.Other(v)
+		adj[v] = append(adj[v], w)
+		adj[w] = append(adj[w], v)
+		msf.Weight += weight(e)
+	}
+
+	// Label each component by DFS over forest edges.
+	var dfs func(int, int)
+	dfs = func(v, id int) {
+		msf.Component[v] = id
+		for _, w := range adj[v] {
+			if msf.Component[w] == -1 {
+				dfs(w, id)
+			}
+		}
+	}
+
+	for v := range V {
+		if msf.Component[v] == -1 {
+			dfs(v, len(msf.Trees))
+			msf.Trees = append(msf.Trees, nil)
+		}
+	}
+
+	for _, e := range edges {
+		id := msf.Component[e.Head()]
+		msf.Trees[id] = append(msf.Trees[id], e)
+	}
+
+	return msf
+}
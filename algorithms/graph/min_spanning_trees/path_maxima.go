@@ -0,0 +1,138 @@
+/* Algorithm: MST Path Maxima (Binary Lifting) */
+
+package mst
+
+import (
+	"azure/data_structures/graph"
+	"math/bits"
+)
+
+type PathMaxima struct {
+	depth []int
+	up    [][]int        // up[j][v] -> 2^j-th ancestor of v
+	maxTo [][]graph.Edge // maxTo[j][v] -> heaviest edge on that jump
+	root  []int          // v -> root of its tree
+}
+
+/*
+Heaviest-edge queries on MST paths.
+- Time: O(V.logV) preprocess, O(logV) query & Space: O(V.logV).
+*/
+func NewPathMaxima(mst *MST) *PathMaxima {
+	V := len(mst.EdgeTo)
+	LOG := max(bits.Len(uint(V)), 1)
+
+	pm := &PathMaxima{
+		depth: make([]int, V),
+		up:    make([][]int, LOG),
+		maxTo: make([][]graph.Edge, LOG),
+		root:  make([]int, V),
+	}
+
+	for j := range LOG {
+		pm.up[j] = make([]int, V)
+		pm.maxTo[j] = make([]graph.Edge, V)
+	}
+
+	// Parents from EdgeTo, children to walk down from each root.
+	children := make([][]int, V)
+	for v, e := range mst.EdgeTo {
+		pm.up[0][v] = v
+		if edgeToParent(v, e) {
+			p := e.Other(v)
+			pm.up[0][v] = p
+			pm.maxTo[0][v] = e
+			children[p] = append(children[p], v)
+		}
+	}
+
+	var dfs func(int)
+	dfs = func(v int) {
+		for _, c := range children[v] {
+			pm.depth[c] = pm.depth[v] + 1
+			pm.root[c] = pm.root[v]
+			dfs(c)
+		}
+	}
+
+	for v := range V {
+		if pm.up[0][v] == v {
+			pm.root[v] = v
+			dfs(v)
+		}
+	}
+
+	for j := 1; j < LOG; j++ {
+		for v := range V {
+			mid := pm.up[j-1][v]
+			pm.up[j][v] = pm.up[j-1][mid]
+			pm.maxTo[j][v] = heavier(pm.maxTo[j-1][v], pm.maxTo[j-1][mid])
+		}
+	}
+
+	return pm
+}
+
+/* Heaviest edge on the tree path between u & v. */
+func (pm *PathMaxima) MaxEdge(u, v int) (graph.Edge, bool) {
+	pm.IsVertexOf(u)
+	pm.IsVertexOf(v)
+
+	var best graph.Edge
+	found := false
+
+	if pm.root[u] != pm.root[v] || u == v {
+		return best, false
+	}
+
+	take := func(j, x int) {
+		e := pm.maxTo[j][x]
+		if !found || e.Weight() > best.Weight() {
+			best, found = e, true
+		}
+	}
+
+	if pm.depth[u] < pm.depth[v] {
+		u, v = v, u
+	}
+
+	// Lift the deeper endpoint to the same depth.
+	for j, diff := 0, pm.depth[u]-pm.depth[v]; diff > 0; j, diff = j+1, diff>>1 {
+		if diff&1 == 1 {
+			take(j, u)
+			u = pm.up[j][u]
+		}
+	}
+
+	// Lift both just below their lowest common ancestor.
+	for j := len(pm.up) - 1; j >= 0 && u != v; j-- {
+		if pm.up[j][u] != pm.up[j][v] {
+			take(j, u)
+			take(j, v)
+			u, v = pm.up[j][u], pm.up[j][v]
+		}
+	}
+
+	if u != v {
+		take(0, u)
+		take(0, v)
+	}
+
+	return best, true
+}
+
+/* Heavier of 2 edges (jumps past a root are never queried). */
+func heavier(a, b graph.Edge) graph.Edge {
+	if a.Weight() >= b.Weight() {
+		return a
+	}
+
+	return b
+}
+
+/* Validate if a vertex belongs to the queried MST. */
+func (pm *PathMaxima) IsVertexOf(v int) {
+	if v < 0 || v >= len(pm.root) {
+		panic("vertex out of bounds")
+	}
+}
@@ -0,0 +1,60 @@
+/* Algorithm: Second-best MST */
+
+package mst
+
+import (
+	"azure/data_structures/graph"
+	"errors"
+)
+
+var ErrNoSecondBest = errors.New("mst: no other spanning tree exists")
+
+/*
+Lightest spanning tree differing from the MST by 1 edge swap.
+- Time: O(E.logV) & Space: O(V.logV).
+*/
+func SecondBestMST(G *graph.Graph, mst *MST) (*MST, error) {
+	pm := NewPathMaxima(mst)
+	tree := mst.Edges()
+
+	// Tree edges as a multiset, so parallel copies stay apart.
+	inTree := make(map[graph.Edge]int, len(tree))
+	for _, e := range tree {
+		inTree[e]++
+	}
+
+	var add, drop graph.Edge
+	bestDelta := INF
+
+	for e := range G.Edges() {
+		if inTree[e] > 0 {
+			inTree[e]--
+			continue
+		}
+
+		// Swap in e, swap out the heaviest edge on its tree cycle.
+		v := e.Head()
+		heaviest, ok := pm.MaxEdge(v, e.Other(v))
+		if ok && e.Weight()-heaviest.Weight() < bestDelta {
+			bestDelta = e.Weight() - heaviest.Weight()
+			add, drop = e, heaviest
+		}
+	}
+
+	if bestDelta == INF {
+		return nil, ErrNoSecondBest
+	}
+
+	edges := make([]graph.Edge, 0, len(tree))
+	dropped := false
+	for _, e := range tree {
+		if !dropped && e == drop {
+			dropped = true
+			continue
+		}
+
+		edges = append(edges, e)
+	}
+
+	return newMST(G.V, append(edges, add), edgeWeight), nil
+}
@@ -0,0 +1,67 @@
+/* Algorithm: Single-linkage Clustering */
+
+package mst
+
+import (
+	"azure/data_structures/graph"
+	unionfind "azure/data_structures/union_find"
+	"cmp"
+	"slices"
+)
+
+type Clustering struct {
+	Labels   []int   // vertex -> cluster ID
+	Clusters [][]int // cluster ID -> its vertices
+}
+
+/*
+Split an MST into k clusters by dropping its k-1 heaviest edges. A forest
+with c > k trees can't be merged further, so it yields c clusters.
+- Time: O(V.logV) & Space: O(V).
+*/
+func KClustering(mst *MST, k int) *Clustering {
+	V := len(mst.EdgeTo)
+	if k < 1 || k > V {
+		panic("invalid number of clusters")
+	}
+
+	edges := mst.Edges()
+	slices.SortFunc(edges, func(a, b graph.Edge) int {
+		return cmp.Compare(a.Weight(), b.Weight())
+	})
+
+	uf := unionfind.NewIntUnionFind(V)
+
+	// Join lightest edges until k clusters remain.
+	for _, e := range edges {
+		if uf.Count() <= k {
+			break
+		}
+
+		v := e.Head()
+		uf.Union(v, e.Other(v))
+	}
+
+	res := &Clustering{Labels: make([]int, V)}
+	for v := range V {
+		res.Labels[v] = -1
+	}
+
+	for v := range V {
+		if res.Labels[v] != -1 {
+			continue
+		}
+
+		id := len(res.Clusters)
+		var members []int
+		for w := range uf.Members(v) {
+			res.Labels[w] = id
+			members = append(members, w)
+		}
+
+		slices.Sort(members)
+		res.Clusters = append(res.Clusters, members)
+	}
+
+	return res
+}
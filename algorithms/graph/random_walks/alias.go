@@ -0,0 +1,74 @@
+/* Algorithm: Vose's Alias Method */
+
+package walks
+
+import "math/rand/v2"
+
+type aliasTable struct {
+	prob  []float64 // chance to keep column i rather than its alias
+	alias []int
+}
+
+/*
+Table for sampling index i with probability weights[i] / sum(weights).
+Nil if every weight is 0.
+- Time: O(n) & Space: O(n).
+*/
+func newAliasTable(weights []int) *aliasTable {
+	n := len(weights)
+	total := 0
+	for _, w := range weights {
+		if w < 0 {
+			panic("negative edge weight")
+		}
+
+		total += w
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	t := &aliasTable{prob: make([]float64, n), alias: make([]int, n)}
+
+	// Scale so the average column holds exactly 1.
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, w := range weights {
+		scaled[i] = float64(w) * float64(n) / float64(total)
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	// Top up every small column with mass from a large one.
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+
+		t.prob[s], t.alias[s] = scaled[s], l
+		scaled[l] -= 1 - scaled[s]
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+
+	// Leftovers are full up to rounding.
+	for _, i := range append(small, large...) {
+		t.prob[i], t.alias[i] = 1, i
+	}
+
+	return t
+}
+
+func (t *aliasTable) sample(rng *rand.Rand) int {
+	i := rng.IntN(len(t.prob))
+	if rng.Float64() < t.prob[i] {
+		return i
+	}
+
+	return t.alias[i]
+}
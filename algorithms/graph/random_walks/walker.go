@@ -0,0 +1,201 @@
+/* API: Random Walks */
+
+package walks
+
+import (
+	"azure/data_structures/graph"
+	"iter"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+type Walker struct {
+	Length   int     // Vertices per walk, start included
+	Weighted bool    // Step proportionally to edge weights, else uniformly
+	P, Q     float64 // node2vec return & in-out parameters, 1 if <= 0
+	Restart  float64 // Chance of jumping back to the start before each step
+	Seed     uint64
+	Workers  int // Pool size, GOMAXPROCS if <= 0
+}
+
+/*
+Stream perVertex walks from every vertex of an Undirected Graph, in rounds
+over the vertices. Each walk draws from its own stream seeded by Seed and
+its index, so the output doesn't depend on Workers.
+- Time: O(perVertex.V.Length) & Space: O(E + V).
+*/
+func (wk *Walker) Walks(G *graph.Graph, perVertex int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		wk.stream(newWalkGraph(G.V, G.Edges(), false), perVertex, yield)
+	}
+}
+
+/*
+Stream perVertex walks from every vertex of a Directed Graph, following
+edge directions. A walk stops early at a vertex without way out.
+- Time: O(perVertex.V.Length) & Space: O(E + V).
+*/
+func (wk *Walker) DirectedWalks(G *graph.Digraph, perVertex int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		wk.stream(newWalkGraph(G.V, G.Edges(), true), perVertex, yield)
+	}
+}
+
+/* Adjacency prepared for sampling. */
+type walkGraph struct {
+	to     [][]int       // vertex -> heads of its edges, parallel edges kept
+	weight [][]int       // edge weights, aligned with to
+	tables []*aliasTable // built lazily for weighted walks
+	near   [][]int       // distinct sorted neighbors, for node2vec distances
+}
+
+/* Undirected edges go both ways, self-loops only once. */
+func newWalkGraph(V int, edges iter.Seq[graph.Edge], directed bool) *walkGraph {
+	g := &walkGraph{to: make([][]int, V), weight: make([][]int, V)}
+	for e := range edges {
+		v := e.Head()
+		w := e.Other(v)
+		g.to[v] = append(g.to[v], w)
+		g.weight[v] = append(g.weight[v], e.Weight())
+
+		if !directed && w != v {
+			g.to[w] = append(g.to[w], v)
+			g.weight[w] = append(g.weight[w], e.Weight())
+		}
+	}
+
+	return g
+}
+
+func (wk *Walker) stream(g *walkGraph, perVertex int, yield func([]int) bool) {
+	V := len(g.to)
+	if V == 0 || perVertex <= 0 || wk.Length <= 0 {
+		return
+	}
+
+	p, q := wk.P, wk.Q
+	if p <= 0 {
+		p = 1
+	}
+
+	if q <= 0 {
+		q = 1
+	}
+
+	biased := p != 1 || q != 1
+	if wk.Weighted {
+		g.tables = make([]*aliasTable, V)
+		for v := range V {
+			g.tables[v] = newAliasTable(g.weight[v])
+		}
+	}
+
+	if biased {
+		g.near = make([][]int, V)
+		for v := range V {
+			g.near[v] = slices.Compact(slices.Sorted(slices.Values(g.to[v])))
+		}
+	}
+
+	workers := wk.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	// Walk in batches, so results come out in order while the pool stays busy.
+	total := perVertex * V
+	batch := max(64*workers, 1)
+	for lo := 0; lo < total; lo += batch {
+		hi := min(lo+batch, total)
+		out := make([][]int, hi-lo)
+
+		var wg sync.WaitGroup
+		jobs := make(chan int)
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range jobs {
+					rng := rand.New(rand.NewPCG(wk.Seed, uint64(i)))
+					out[i-lo] = wk.walk(g, i%V, p, q, biased, rng)
+				}
+			}()
+		}
+
+		for i := lo; i < hi; i++ {
+			jobs <- i
+		}
+
+		close(jobs)
+		wg.Wait()
+
+		for _, walk := range out {
+			if !yield(walk) {
+				return
+			}
+		}
+	}
+}
+
+func (wk *Walker) walk(g *walkGraph, start int, p, q float64, biased bool, rng *rand.Rand) []int {
+	walk := make([]int, 1, wk.Length)
+	walk[0] = start
+
+	prev := -1
+	for len(walk) < wk.Length {
+		v := walk[len(walk)-1]
+		if wk.Restart > 0 && len(walk) > 1 && rng.Float64() < wk.Restart {
+			walk = append(walk, start)
+			prev = -1
+			continue
+		}
+
+		next := g.step(v, prev, p, q, biased, rng)
+		if next == -1 {
+			break
+		}
+
+		walk = append(walk, next)
+		prev = v
+	}
+
+	return walk
+}
+
+/*
+Next vertex after v, -1 at a dead end. node2vec biases are applied by
+rejection: a first-order proposal x is kept with chance alpha / max(alpha),
+where alpha is 1/p going back to prev, 1 staying next to prev, 1/q otherwise.
+*/
+func (g *walkGraph) step(v, prev int, p, q float64, biased bool, rng *rand.Rand) int {
+	if len(g.to[v]) == 0 || g.tables != nil && g.tables[v] == nil {
+		return -1
+	}
+
+	bound := max(1/p, 1, 1/q)
+	for {
+		var x int
+		if g.tables != nil {
+			x = g.to[v][g.tables[v].sample(rng)]
+		} else {
+			x = g.to[v][rng.IntN(len(g.to[v]))]
+		}
+
+		if !biased || prev == -1 {
+			return x
+		}
+
+		alpha := 1 / q
+		if x == prev {
+			alpha = 1 / p
+		} else if _, ok := slices.BinarySearch(g.near[prev], x); ok {
+			alpha = 1
+		}
+
+		if rng.Float64()*bound < alpha {
+			return x
+		}
+	}
+}
@@ -12,10 +12,20 @@ Acyclical Shortest Path on a DAG.
 - Time: O(E + V) & Space: O(V).
 */
 func AcyclicalSP(G *graph.Digraph, src int) *SP {
+	return AcyclicalSPFunc(G, src, edgeWeight)
+}
+
+/*
+Acyclical Shortest Path with weights drawn from each edge by weight.
+- Time: O(E + V) & Space: O(V).
+*/
+func AcyclicalSPFunc[VP, EP any](
+	G *graph.DigraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+) *SPOf[EP] {
 	// Always create the SP object first.
-	sp := &SP{
+	sp := &SPOf[EP]{
 		DistTo: make([]int, G.V),
-		EdgeTo: make([]graph.Edge, G.V),
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
 		Source: src,
 	}
 
@@ -31,7 +41,7 @@ func AcyclicalSP(G *graph.Digraph, src int) *SP {
 		if sp.DistTo[v] != INF { // Only reachable vertex
 			for e := range G.Adjacent(v) {
 				w := e.Other(v)
-				newDist := sp.DistTo[v] + e.Weight()
+				newDist := sp.DistTo[v] + weight(e)
 				if newDist < sp.DistTo[w] { 
 					sp.DistTo[w] = newDist // Relax edge
 					sp.EdgeTo[w] = e
@@ -9,9 +9,19 @@ Array-variant Dijkstra Shortest Path on Weighted Digraph.
 - Time: O(V^2) & Space: O(V).
 */
 func ArrayDijkstraSP(G *graph.Digraph, src int) *SP {
-	sp := &SP{
+	return ArrayDijkstraSPFunc(G, src, edgeWeight)
+}
+
+/*
+Array-variant Dijkstra with weights drawn from each edge by weight.
+- Time: O(V^2) & Space: O(V).
+*/
+func ArrayDijkstraSPFunc[VP, EP any](
+	G *graph.DigraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+) *SPOf[EP] {
+	sp := &SPOf[EP]{
 		DistTo: make([]int, G.V),
-		EdgeTo: make([]graph.Edge, G.V),
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
 		Source: src,
 	}
 
@@ -34,13 +44,18 @@ func ArrayDijkstraSP(G *graph.Digraph, src int) *SP {
 			}
 		}
 
+		// Only unreachable vertices left -> Done.
+		if minV == -1 {
+			break
+		}
+
 		// Mark computed vertex.
 		marked[minV] = true
 
 		// Relax all adjacent edges of the newly vertex.
 		for e := range G.Adjacent(minV) {
 			w := e.Other(minV)
-			newDist := minDist + e.Weight()
+			newDist := minDist + weight(e)
 			if !marked[w] && newDist < sp.DistTo[w] {
 				sp.DistTo[w] = newDist
 				sp.EdgeTo[w] = e
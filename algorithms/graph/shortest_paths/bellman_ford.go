@@ -9,9 +9,19 @@ Shortest Path on negative edge weight Digraph.
 - Time: O(E.V) & Space: O(V).
 */
 func BellmanFordSP(G *graph.Digraph, src int) *SP {
-	sp := &SP{
+	return BellmanFordSPFunc(G, src, edgeWeight)
+}
+
+/*
+Bellman-Ford with weights drawn from each edge by weight.
+- Time: O(E.V) & Space: O(V).
+*/
+func BellmanFordSPFunc[VP, EP any](
+	G *graph.DigraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+) *SPOf[EP] {
+	sp := &SPOf[EP]{
 		DistTo: make([]int, G.V),
-		EdgeTo: make([]graph.Edge, G.V),
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
 		Source: src,
 	}
 
@@ -33,7 +43,7 @@ func BellmanFordSP(G *graph.Digraph, src int) *SP {
 
 			for e := range G.Adjacent(v) {
 				w := e.Other(v)
-				newDist := sp.DistTo[v] + e.Weight()
+				newDist := sp.DistTo[v] + weight(e)
 				if newDist < sp.DistTo[w] {
 					sp.DistTo[w] = newDist // Relax edge
 					sp.EdgeTo[w] = e
@@ -54,7 +64,7 @@ func BellmanFordSP(G *graph.Digraph, src int) *SP {
 		}
 
 		for e := range G.Adjacent(v) {
-			if sp.DistTo[e.Other(v)] < sp.DistTo[v] + e.Weight() {
+			if sp.DistTo[v] + weight(e) < sp.DistTo[e.Other(v)] {
 				panic("negative cycle detected")
 			} 
 		}
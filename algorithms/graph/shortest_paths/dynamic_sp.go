@@ -0,0 +1,276 @@
+/* Algorithm: Dynamic Shortest Paths (Ramalingam-Reps) */
+
+package sp
+
+import (
+	"azure/algorithms/array"
+	"azure/data_structures/graph"
+	pq "azure/data_structures/priority_queue"
+)
+
+/*
+Single-source shortest paths kept up to date under edge insertions,
+removals & weight changes, touching only the vertices whose paths change.
+Edges are known by id: those of the initial Digraph in Edges() order,
+then 1 per AddEdge. Weights must be non-negative.
+*/
+type DynamicSP struct {
+	Source  int
+	distTo  []int
+	edgeTo  []int // vertex -> id of its tree edge, -1 if none
+	edges   []graph.Edge
+	removed []bool
+	out, in [][]int // vertex -> ids of its outgoing & incoming edges
+	minpq   *pq.IndexPQ[int]
+}
+
+/*
+Shortest paths tree of a Digraph, ready for updates.
+- Time: O(E.logV) & Space: O(E + V).
+*/
+func NewDynamicSP(G *graph.Digraph, src int) *DynamicSP {
+	G.IsVertexOf(src)
+	d := &DynamicSP{
+		Source: src,
+		distTo: make([]int, G.V),
+		edgeTo: make([]int, G.V),
+		out:    make([][]int, G.V),
+		in:     make([][]int, G.V),
+		minpq:  pq.NewIndexPQ(G.V, func(a, b int) bool { return a < b }),
+	}
+
+	for v := range G.V {
+		d.distTo[v] = INF
+		d.edgeTo[v] = -1
+	}
+
+	for e := range G.Edges() {
+		d.link(e)
+	}
+
+	d.distTo[src] = 0
+	d.minpq.Enqueue(src, 0)
+	d.propagate()
+
+	return d
+}
+
+/*
+Insert an edge & return its id.
+- Time: O(k.logk), k ~ edges around vertices whose distance drops.
+*/
+func (d *DynamicSP) AddEdge(e graph.Edge) int {
+	d.isVertexOf(e.Head())
+	d.isVertexOf(e.Other(e.Head()))
+
+	id := d.link(e)
+	d.decreased(id)
+
+	return id
+}
+
+/*
+Change the weight of an edge, either way.
+- Time: O(k.logk), k ~ edges around the repaired region.
+*/
+func (d *DynamicSP) SetWeight(id, weight int) {
+	if weight < 0 {
+		panic("negative edge weight")
+	}
+
+	e := d.Edge(id)
+	v := e.Head()
+	d.edges[id] = *graph.NewEdge(v, e.Other(v), weight)
+
+	switch {
+	case d.removed[id]:
+	case weight < e.Weight():
+		d.decreased(id)
+	case weight > e.Weight():
+		d.increased(id)
+	}
+}
+
+/*
+Delete an edge, its id isn't reused.
+- Time: O(k.logk), k ~ edges around the repaired region.
+*/
+func (d *DynamicSP) RemoveEdge(id int) {
+	d.Edge(id)
+	if !d.removed[id] {
+		d.removed[id] = true
+		d.increased(id)
+	}
+}
+
+/* Current edge with a given id. */
+func (d *DynamicSP) Edge(id int) graph.Edge {
+	if id < 0 || id >= len(d.edges) {
+		panic("edge id out of bounds")
+	}
+
+	return d.edges[id]
+}
+
+/* Length of the shortest path to v, INF if unreachable. */
+func (d *DynamicSP) DistTo(v int) int {
+	d.isVertexOf(v)
+	return d.distTo[v]
+}
+
+func (d *DynamicSP) HasPathTo(v int) bool {
+	return d.DistTo(v) < INF
+}
+
+/* Edges of a shortest path from the source to v, nil if unreachable. */
+func (d *DynamicSP) PathTo(v int) []graph.Edge {
+	if !d.HasPathTo(v) {
+		return nil
+	}
+
+	var path []graph.Edge
+	for id := d.edgeTo[v]; id != -1; {
+		e := d.edges[id]
+		path = append(path, e)
+		id = d.edgeTo[e.Head()]
+	}
+
+	array.Reverse(path)
+	return path
+}
+
+/* Copy of the current tree in the format of the static algorithms. */
+func (d *DynamicSP) SP() *SP {
+	sp := &SP{
+		DistTo: append([]int(nil), d.distTo...),
+		EdgeTo: make([]graph.Edge, len(d.distTo)),
+		Source: d.Source,
+	}
+
+	for v, id := range d.edgeTo {
+		if id != -1 {
+			sp.EdgeTo[v] = d.edges[id]
+		}
+	}
+
+	return sp
+}
+
+func (d *DynamicSP) link(e graph.Edge) int {
+	if e.Weight() < 0 {
+		panic("negative edge weight")
+	}
+
+	id := len(d.edges)
+	v := e.Head()
+	d.edges = append(d.edges, e)
+	d.removed = append(d.removed, false)
+	d.out[v] = append(d.out[v], id)
+	d.in[e.Other(v)] = append(d.in[e.Other(v)], id)
+
+	return id
+}
+
+/* Cheaper edge: only vertices it now gives a shorter path get relaxed. */
+func (d *DynamicSP) decreased(id int) {
+	e := d.edges[id]
+	u := e.Head()
+	v := e.Other(u)
+	if d.distTo[u] == INF || d.distTo[u]+e.Weight() >= d.distTo[v] {
+		return
+	}
+
+	d.distTo[v] = d.distTo[u] + e.Weight()
+	d.edgeTo[v] = id
+
+	d.minpq.Enqueue(v, d.distTo[v])
+	d.propagate()
+}
+
+/*
+Dearer or removed edge: only a tree edge matters. Its subtree loses its
+distances, each vertex is reseeded from its best edge coming from outside,
+then Dijkstra settles the subtree alone.
+*/
+func (d *DynamicSP) increased(id int) {
+	v := d.edges[id].Other(d.edges[id].Head())
+	if d.edgeTo[v] != id {
+		return
+	}
+
+	affected := make(map[int]bool)
+	stack := []int{v}
+	for len(stack) > 0 {
+		x := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		affected[x] = true
+
+		for _, child := range d.out[x] {
+			if y := d.edges[child].Other(x); d.edgeTo[y] == child {
+				stack = append(stack, y)
+			}
+		}
+	}
+
+	for x := range affected {
+		d.distTo[x] = INF
+		d.edgeTo[x] = -1
+	}
+
+	for y := range affected {
+		for _, in := range d.in[y] {
+			e := d.edges[in]
+			x := e.Head()
+			if d.removed[in] || affected[x] || d.distTo[x] == INF {
+				continue
+			}
+
+			if dist := d.distTo[x] + e.Weight(); dist < d.distTo[y] {
+				d.distTo[y] = dist
+				d.edgeTo[y] = in
+			}
+		}
+
+		if d.distTo[y] < INF {
+			d.minpq.Enqueue(y, d.distTo[y])
+		}
+	}
+
+	d.propagate()
+}
+
+/* Dijkstra from the queued vertices, draining the queue for the next update. */
+func (d *DynamicSP) propagate() {
+	for !d.minpq.IsEmpty() {
+		x, dist, ok := d.minpq.Dequeue()
+
+		if !ok {
+			panic("attempt to dequeue an empty PQ")
+		}
+
+		for _, id := range d.out[x] {
+			if d.removed[id] {
+				continue
+			}
+
+			e := d.edges[id]
+			y := e.Other(x)
+			if newDist := dist + e.Weight(); newDist < d.distTo[y] {
+				d.distTo[y] = newDist
+				d.edgeTo[y] = id
+
+				if d.minpq.Contains(y) {
+					d.minpq.ChangeKey(y, newDist)
+				} else {
+					d.minpq.Enqueue(y, newDist)
+				}
+			}
+		}
+	}
+}
+
+func (d *DynamicSP) isVertexOf(v int) {
+	if v < 0 || v >= len(d.distTo) {
+		panic("vertex out of bounds")
+	}
+}
@@ -12,9 +12,19 @@ Dijkstra Shortest Path on Weighted Digraph (Lazy variant).
 - Time: O(E.logE) & Space: O(E + V).
 */
 func LazyDijkstraSP(G *graph.Digraph, src int) *SP {
-	sp := &SP{
+	return LazyDijkstraSPFunc(G, src, edgeWeight)
+}
+
+/*
+Lazy Dijkstra with weights drawn from each edge by weight.
+- Time: O(E.logE) & Space: O(E + V).
+*/
+func LazyDijkstraSPFunc[VP, EP any](
+	G *graph.DigraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+) *SPOf[EP] {
+	sp := &SPOf[EP]{
 		DistTo: make([]int, G.V),
-		EdgeTo: make([]graph.Edge, G.V),
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
 		Source: src,
 	}
 
@@ -46,7 +56,7 @@ func LazyDijkstraSP(G *graph.Digraph, src int) *SP {
 
 		for e := range G.Adjacent(v) {
 			w := e.Other(v)
-			newDist := sp.DistTo[v] + e.Weight()
+			newDist := sp.DistTo[v] + weight(e)
 			if newDist < sp.DistTo[w] {
 				sp.DistTo[w] = newDist // Relax edge
 				sp.EdgeTo[w] = e
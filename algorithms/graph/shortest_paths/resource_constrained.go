@@ -0,0 +1,133 @@
+/* Algorithm: Resource-Constrained Shortest Path (Label Setting) */
+
+package sp
+
+import (
+	"azure/algorithms/array"
+	"azure/data_structures/graph"
+	pq "azure/data_structures/priority_queue"
+	"errors"
+)
+
+var ErrNoFeasiblePath = errors.New("sp: no path within the resource budget")
+
+type ConstrainedPath[P any] struct {
+	Edges    []graph.EdgeOf[P]
+	Cost     int // sum of edge weights
+	Resource int // sum of consumed resource
+}
+
+/*
+Cheapest src -> dst path consuming at most budget of a second resource,
+by label setting: labels leave the queue by (cost, resource), and one is
+kept only if it uses less resource than every cheaper label at its vertex.
+Labels that can't reach dst within budget are pruned up front.
+- Time: O(L.logL) & Space: O(L + E), L ~ labels created (pseudo-polynomial).
+*/
+func ResourceConstrainedSP[VP, EP any](
+	G *graph.DigraphOf[VP, EP], src, dst, budget int, resource func(graph.EdgeOf[EP]) int,
+) (*ConstrainedPath[EP], error) {
+	G.IsVertexOf(src)
+	G.IsVertexOf(dst)
+
+	// Least resource from every vertex to dst, over the reversed edges.
+	type arc struct {
+		from, res int
+	}
+
+	into := make([][]arc, G.V)
+	for e := range G.Edges() {
+		r := resource(e)
+		if r < 0 || e.Weight() < 0 {
+			panic("negative edge weight")
+		}
+
+		into[e.Other(e.Head())] = append(into[e.Other(e.Head())], arc{e.Head(), r})
+	}
+
+	least := make([]int, G.V)
+	for v := range G.V {
+		least[v] = INF
+	}
+
+	least[dst] = 0
+	minpq := pq.NewIndexPQ(G.V, func(a, b int) bool { return a < b })
+	minpq.Enqueue(dst, 0)
+	for !minpq.IsEmpty() {
+		w, res, ok := minpq.Dequeue()
+
+		if !ok {
+			panic("attempt to dequeue an empty PQ")
+		}
+
+		for _, a := range into[w] {
+			if res+a.res < least[a.from] {
+				least[a.from] = res + a.res
+				if minpq.Contains(a.from) {
+					minpq.ChangeKey(a.from, least[a.from])
+				} else {
+					minpq.Enqueue(a.from, least[a.from])
+				}
+			}
+		}
+	}
+
+	if least[src] > budget {
+		return nil, ErrNoFeasiblePath
+	}
+
+	type label struct {
+		vertex, cost, res int
+		prev              int // index of the previous label, -1 at src
+		edge              graph.EdgeOf[EP]
+	}
+
+	labels := []label{{vertex: src, prev: -1}}
+	queue := pq.NewPQ(func(a, b int) bool {
+		la, lb := labels[a], labels[b]
+		if la.cost != lb.cost {
+			return la.cost < lb.cost
+		}
+
+		return la.res < lb.res
+	})
+	queue.Enqueue(0)
+
+	// Least resource of any settled label per vertex; cheaper ones came first.
+	settled := make([]int, G.V)
+	for v := range G.V {
+		settled[v] = INF
+	}
+
+	for !queue.IsEmpty() {
+		i := queue.Dequeue()
+		l := labels[i]
+		if l.res >= settled[l.vertex] {
+			continue // Dominated
+		}
+
+		settled[l.vertex] = l.res
+		if l.vertex == dst {
+			path := &ConstrainedPath[EP]{Cost: l.cost, Resource: l.res}
+			for j := i; labels[j].prev != -1; j = labels[j].prev {
+				path.Edges = append(path.Edges, labels[j].edge)
+			}
+
+			array.Reverse(path.Edges)
+			return path, nil
+		}
+
+		for e := range G.Adjacent(l.vertex) {
+			w := e.Other(l.vertex)
+			res := l.res + resource(e)
+			if least[w] == INF || res+least[w] > budget || res >= settled[w] {
+				continue
+			}
+
+			labels = append(labels, label{w, l.cost + e.Weight(), res, i, e})
+			queue.Enqueue(len(labels) - 1)
+		}
+	}
+
+	return nil, ErrNoFeasiblePath
+}
@@ -6,8 +6,16 @@ import "azure/data_structures/graph"
 
 const INF = 1<<63 - 1
 
-type SP struct {
-	EdgeTo []graph.Edge
+/* Shortest paths tree over edges with payloads of type P. */
+type SPOf[P any] struct {
+	EdgeTo []graph.EdgeOf[P]
 	DistTo []int
 	Source int
 }
+
+type SP = SPOf[struct{}]
+
+/* Default weight extractor: the edge's own weight. */
+func edgeWeight[P any](e graph.EdgeOf[P]) int {
+	return e.Weight()
+}
@@ -12,9 +12,19 @@ Queue-optimized Shortest Path on negative edge weight Digraph.
 - Time: O(k.E) average, O(E.V) worst & Space: O(V).
 */
 func ShortestPathFasterSP(G *graph.Digraph, src int) *SP {
-	sp := &SP{
+	return ShortestPathFasterSPFunc(G, src, edgeWeight)
+}
+
+/*
+Queue-optimized Shortest Path with weights drawn from each edge by weight.
+- Time: O(k.E) average, O(E.V) worst & Space: O(V).
+*/
+func ShortestPathFasterSPFunc[VP, EP any](
+	G *graph.DigraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+) *SPOf[EP] {
+	sp := &SPOf[EP]{
 		DistTo: make([]int, G.V),
-		EdgeTo: make([]graph.Edge, G.V),
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
 		Source: src,
 	}
 
@@ -43,7 +53,7 @@ func ShortestPathFasterSP(G *graph.Digraph, src int) *SP {
 
 		for e := range G.Adjacent(v) {
 			w := e.Other(v)
-			newDist := sp.DistTo[v] + e.Other(w)
+			newDist := sp.DistTo[v] + weight(e)
 			if newDist < sp.DistTo[w] {
 				sp.DistTo[w] = newDist // Relax edge
 				sp.EdgeTo[w] = e
@@ -0,0 +1,163 @@
+/* Algorithm: Time-Dependent Dijkstra */
+
+package sp
+
+import (
+	"azure/data_structures/graph"
+	pq "azure/data_structures/priority_queue"
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+)
+
+var (
+	ErrInvalidProfile = errors.New("sp: invalid travel time profile")
+	ErrNotFIFO        = errors.New("sp: profile lets later departures arrive earlier")
+)
+
+type Breakpoint struct {
+	Time, Travel int // departure time -> travel time
+}
+
+/*
+Piecewise-linear travel time of an edge over departure times, constant
+before the first & after the last breakpoint.
+*/
+type Profile struct {
+	points []Breakpoint
+}
+
+/*
+Profile through the given breakpoints, which must have increasing times,
+non-negative travel times & FIFO slopes (never below -1).
+*/
+func NewProfile(points ...Breakpoint) (*Profile, error) {
+	if len(points) == 0 {
+		return nil, fmt.Errorf("%w: no breakpoints", ErrInvalidProfile)
+	}
+
+	for i, p := range points {
+		if p.Travel < 0 {
+			return nil, fmt.Errorf("%w: negative travel time at %d", ErrInvalidProfile, p.Time)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		prev := points[i-1]
+		if p.Time <= prev.Time {
+			return nil, fmt.Errorf("%w: breakpoint times must increase", ErrInvalidProfile)
+		}
+
+		// Waiting must never pay off: arrival times can't decrease.
+		if prev.Travel > p.Travel && uint64(prev.Travel-p.Travel) > gap(prev.Time, p.Time) {
+			return nil, fmt.Errorf("%w: between %d and %d", ErrNotFIFO, prev.Time, p.Time)
+		}
+	}
+
+	return &Profile{points: slices.Clone(points)}, nil
+}
+
+/* Travel time when leaving at t, rounded down between breakpoints. */
+func (p *Profile) Travel(t int) int {
+	pts := p.points
+	i, found := slices.BinarySearchFunc(pts, t, func(b Breakpoint, t int) int {
+		return b.Time - t
+	})
+
+	switch {
+	case found:
+		return pts[i].Travel
+	case i == 0:
+		return pts[0].Travel
+	case i == len(pts):
+		return pts[len(pts)-1].Travel
+	}
+
+	// Slope times elapsed time needs 128 bits, the quotient fits back.
+	a, b := pts[i-1], pts[i]
+	span, elapsed := gap(a.Time, b.Time), gap(a.Time, t)
+	if b.Travel >= a.Travel {
+		hi, lo := bits.Mul64(uint64(b.Travel-a.Travel), elapsed)
+		q, _ := bits.Div64(hi, lo, span)
+		return a.Travel + int(q)
+	}
+
+	hi, lo := bits.Mul64(uint64(a.Travel-b.Travel), elapsed)
+	q, r := bits.Div64(hi, lo, span)
+	if r != 0 {
+		q++ // Round down a falling slope
+	}
+
+	return a.Travel - int(q)
+}
+
+/* Arrival time when leaving at t. */
+func (p *Profile) Arrival(t int) int {
+	return t + p.Travel(t)
+}
+
+/* Distance from a to b >= a, exact even past the int range. */
+func gap(a, b int) uint64 {
+	return uint64(b) - uint64(a)
+}
+
+/*
+Earliest arrivals leaving src at depart, edges timed by their profile
+(nil ~ constant at the edge's weight). DistTo holds travel times since
+depart. FIFO profiles make waiting useless, so Dijkstra stays exact.
+- Time: O(E.(logV + logB)) & Space: O(V), B ~ breakpoints per profile.
+*/
+func TimeDependentSP[VP, EP any](
+	G *graph.DigraphOf[VP, EP], src, depart int, profile func(graph.EdgeOf[EP]) *Profile,
+) *SPOf[EP] {
+	G.IsVertexOf(src)
+	sp := &SPOf[EP]{
+		DistTo: make([]int, G.V),
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
+		Source: src,
+	}
+
+	for v := range G.V {
+		sp.DistTo[v] = INF
+	}
+
+	sp.DistTo[src] = 0
+	minpq := pq.NewIndexPQ(
+		G.V, func(a, b int) bool { return a < b },
+	)
+	minpq.Enqueue(src, depart)
+
+	for !minpq.IsEmpty() {
+		v, at, ok := minpq.Dequeue()
+
+		if !ok {
+			panic("attempt to dequeue an empty PQ")
+		}
+
+		for e := range G.Adjacent(v) {
+			arrival := at + e.Weight()
+			if p := profile(e); p != nil {
+				arrival = p.Arrival(at)
+			} else if e.Weight() < 0 {
+				panic("negative edge weight")
+			}
+
+			w := e.Other(v)
+			if newDist := arrival - depart; newDist < sp.DistTo[w] {
+				sp.EdgeTo[w] = e
+				sp.DistTo[w] = newDist
+
+				if minpq.Contains(w) {
+					minpq.ChangeKey(w, arrival)
+				} else {
+					minpq.Enqueue(w, arrival)
+				}
+			}
+		}
+	}
+
+	return sp
+}
@@ -0,0 +1,63 @@
+/* Algorithm: Dijkstra (Weight Extractor) */
+
+package sp
+
+import (
+	"azure/data_structures/graph"
+	pq "azure/data_structures/priority_queue"
+)
+
+/*
+Dijkstra Shortest Path with weights drawn from each edge by weight,
+e.g. travel time from a payload's length & speed limit (Eager variant).
+- Time: O(E.logV) & Space: O(V).
+*/
+func DijkstraSPFunc[VP, EP any](
+	G *graph.DigraphOf[VP, EP], src int, weight func(graph.EdgeOf[EP]) int,
+) *SPOf[EP] {
+	G.IsVertexOf(src)
+	sp := &SPOf[EP]{
+		DistTo: make([]int, G.V),
+		EdgeTo: make([]graph.EdgeOf[EP], G.V),
+		Source: src,
+	}
+
+	for v := range G.V {
+		sp.DistTo[v] = INF
+	}
+
+	sp.DistTo[src] = 0
+	minpq := pq.NewIndexPQ(
+		G.V, func(a, b int) bool { return a < b },
+	)
+	minpq.Enqueue(src, sp.DistTo[src])
+
+	for !minpq.IsEmpty() {
+		v, dist, ok := minpq.Dequeue()
+
+		if !ok {
+			panic("attempt to dequeue an empty PQ")
+		}
+
+		for e := range G.Adjacent(v) {
+			cost := weight(e)
+			if cost < 0 {
+				panic("negative edge weight")
+			}
+
+			w := e.Other(v)
+			if newDist := dist + cost; newDist < sp.DistTo[w] {
+				sp.EdgeTo[w] = e
+				sp.DistTo[w] = newDist // Relax edge
+
+				if minpq.Contains(w) {
+					minpq.ChangeKey(w, newDist)
+				} else {
+					minpq.Enqueue(w, newDist)
+				}
+			}
+		}
+	}
+
+	return sp
+}
@@ -0,0 +1,167 @@
+/* API: DAG Task Executor */
+
+package topological
+
+import (
+	"azure/data_structures/graph"
+	stackqueue "azure/data_structures/stack_queue"
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"time"
+)
+
+type Executor struct {
+	Workers         int  // Pool size, GOMAXPROCS if <= 0
+	ContinueOnError bool // Keep running tasks not depending on failures
+}
+
+type TaskReport struct {
+	Start, End time.Time
+	Err        error
+	Skipped    bool // Never ran: failed prerequisite or stopped run
+}
+
+type ExecReport struct {
+	Tasks   []TaskReport // vertex -> its report
+	Elapsed time.Duration
+}
+
+/* Wall-clock running time of a task. */
+func (tr *TaskReport) Duration() time.Duration {
+	return tr.End.Sub(tr.Start)
+}
+
+/*
+Run every task of a DAG once all its prerequisites succeeded.
+- Time: O(E + V) scheduling & Space: O(V).
+*/
+func (ex *Executor) Run(
+	ctx context.Context, G *graph.Digraph, task func(context.Context, int) error,
+) (*ExecReport, error) {
+	if _, err := TopologicalOrder(G); err != nil {
+		return nil, err
+	}
+
+	workers := ex.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	report := &ExecReport{Tasks: make([]TaskReport, G.V)}
+	begin := time.Now()
+
+	type result struct {
+		v          int
+		start, end time.Time
+		err        error
+	}
+
+	jobs := make(chan int)
+	results := make(chan result)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range jobs {
+				start := time.Now()
+				err := task(ctx, v)
+				results <- result{v, start, time.Now(), err}
+			}
+		}()
+	}
+
+	// Same in-degree bookkeeping as TopologicalBFS.
+	indeg := make([]int, G.V)
+	blocked := make([]bool, G.V)
+	finished := make([]bool, G.V)
+	ready := stackqueue.NewQueue[int](G.V)
+	for v := range G.V {
+		indeg[v] = G.Indegree(v)
+		if indeg[v] == 0 {
+			ready.Enqueue(v)
+		}
+	}
+
+	done := 0
+	var release func(int, bool)
+	release = func(v int, ok bool) {
+		for e := range G.Adjacent(v) {
+			w := e.Other(v)
+			indeg[w]--
+			blocked[w] = blocked[w] || !ok
+
+			if indeg[w] == 0 {
+				if blocked[w] { // Skip & propagate failure.
+					report.Tasks[w].Skipped = true
+					finished[w] = true
+					done++
+					release(w, false)
+				} else {
+					ready.Enqueue(w)
+				}
+			}
+		}
+	}
+
+	var errs []error
+	inflight := 0
+	stopping := false
+	cancelled := ctx.Done()
+
+	for done < G.V && !(stopping && inflight == 0) {
+		// Only offer a job when there's one & we still schedule.
+		var send chan int
+		next, hasNext := ready.Front()
+		if !stopping && hasNext {
+			send = jobs
+		}
+
+		select {
+		case send <- next:
+			ready.Dequeue()
+			inflight++
+		case r := <-results:
+			inflight--
+			done++
+			finished[r.v] = true
+			report.Tasks[r.v] = TaskReport{Start: r.start, End: r.end, Err: r.err}
+
+			if r.err != nil {
+				errs = append(errs, r.err)
+				if !ex.ContinueOnError { // Fail-fast
+					stopping = true
+					cancel()
+				}
+			}
+
+			release(r.v, r.err == nil)
+		case <-cancelled:
+			stopping = true
+			cancelled = nil
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	for v := range G.V {
+		if !finished[v] {
+			report.Tasks[v].Skipped = true
+		}
+	}
+
+	report.Elapsed = time.Since(begin)
+
+	if ctxErr := context.Cause(ctx); ctxErr != nil && len(errs) == 0 {
+		errs = append(errs, ctxErr)
+	}
+
+	return report, errors.Join(errs...)
+}
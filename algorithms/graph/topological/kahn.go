@@ -4,6 +4,7 @@ package topological
 
 import (
 	"azure/data_structures/graph"
+	pq "azure/data_structures/priority_queue"
 	stackqueue "azure/data_structures/stack_queue"
 )
 
@@ -11,9 +12,28 @@ import (
 Topological order of vertices of a Digraph (BFS).
 - Time: O(E + V) & Space: O(V).
 */
-func TopologicalBFS(G *graph.Digraph) []int {
-	indeg := make([]int, G.V)
+func TopologicalBFS[VP, EP any](G *graph.DigraphOf[VP, EP]) []int {
+	waves, err := TopologicalWaves(G)
+	if err != nil {
+		panic("non-acyclical input Digraph")
+	}
+
 	topo := make([]int, 0, G.V)
+	for _, wave := range waves {
+		topo = append(topo, wave...)
+	}
+
+	return topo
+}
+
+/*
+Layers of a Digraph: each wave only depends on earlier waves.
+- Time: O(E + V) & Space: O(V).
+*/
+func TopologicalWaves[VP, EP any](G *graph.DigraphOf[VP, EP]) ([][]int, error) {
+	indeg := make([]int, G.V)
+	var waves [][]int
+	count := 0
 
 	queue := stackqueue.NewQueue[int](G.V)
 	for v := range G.V {
@@ -27,15 +47,16 @@ func TopologicalBFS(G *graph.Digraph) []int {
 	for !queue.IsEmpty() {
 		// Peel all surface vertices.
 		len := queue.Len()
+		wave := make([]int, 0, len)
 		for range len {
 			v, ok := queue.Dequeue()
-			
+
 			if !ok {
 				panic("attempt to dequeue an empty Queue")
 			}
 
-			// Append them into the order Array.
-			topo = append(topo, v)
+			// Append them into the current wave.
+			wave = append(wave, v)
 
 			for e := range G.Adjacent(v) {
 				w := e.Other(v)
@@ -46,11 +67,57 @@ func TopologicalBFS(G *graph.Digraph) []int {
 				}
 			}
 		}
+
+		waves = append(waves, wave)
+		count += len
+	}
+
+	if count != G.V {
+		_, err := TopologicalOrder(G)
+		return nil, err
+	}
+
+	return waves, nil
+}
+
+/*
+Topological order always taking the best ready vertex by comparator.
+- Time: O(E + V.logV) & Space: O(V).
+*/
+func TopologicalPQ(G *graph.Digraph, less func(v, w int) bool) ([]int, error) {
+	indeg := make([]int, G.V)
+	topo := make([]int, 0, G.V)
+
+	ready := pq.NewPQ(less)
+	for v := range G.V {
+		indeg[v] = G.Indegree(v)
+		if indeg[v] == 0 {
+			ready.Enqueue(v)
+		}
+	}
+
+	for !ready.IsEmpty() {
+		v := ready.Dequeue()
+		topo = append(topo, v)
+
+		for e := range G.Adjacent(v) {
+			w := e.Other(v)
+			indeg[w] -= 1
+			if indeg[w] == 0 {
+				ready.Enqueue(w)
+			}
+		}
 	}
 
 	if len(topo) != G.V {
-		panic("non-acyclical input Digraph")
+		_, err := TopologicalOrder(G)
+		return nil, err
 	}
 
-	return topo
+	return topo, nil
+}
+
+/* Lexicographically smallest topological order of a Digraph. */
+func LexicographicTopological(G *graph.Digraph) ([]int, error) {
+	return TopologicalPQ(G, func(v, w int) bool { return v < w })
 }
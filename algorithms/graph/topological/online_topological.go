@@ -0,0 +1,171 @@
+/* Algorithm: Online Topological Order (Pearce-Kelly) */
+
+package topological
+
+import (
+	"azure/data_structures/graph"
+	"cmp"
+	"iter"
+	"slices"
+)
+
+type OnlineTopological struct {
+	g    *graph.Digraph
+	ord  []int   // vertex -> position in the order
+	at   []int   // position -> vertex
+	radj [][]int // vertex -> predecessors
+}
+
+/* Maintain a topological order of a copy of a DAG under edge insertions. */
+func NewOnlineTopological(G *graph.Digraph) (*OnlineTopological, error) {
+	order, err := TopologicalOrder(G)
+	if err != nil {
+		return nil, err
+	}
+
+	ot := &OnlineTopological{
+		g:    graph.NewDigraph(G.V),
+		ord:  make([]int, G.V),
+		at:   order,
+		radj: make([][]int, G.V),
+	}
+
+	for i, v := range order {
+		ot.ord[v] = i
+	}
+
+	for e := range G.Edges() {
+		v := e.Head()
+		ot.g.AddEdge(e)
+		ot.radj[e.Other(v)] = append(ot.radj[e.Other(v)], v)
+	}
+
+	return ot, nil
+}
+
+/*
+Insert an edge, reordering only the affected region.
+- Time: O(E' + V'.logV') on the affected region & Space: O(V').
+*/
+func (ot *OnlineTopological) AddEdge(e graph.Edge) error {
+	v := e.Head()
+	w := e.Other(v)
+	ot.g.IsVertexOf(v)
+	ot.g.IsVertexOf(w)
+
+	if v == w {
+		return &CycleError{Cycle: []int{v}}
+	}
+
+	// Already consistent -> Nothing to repair.
+	if ot.ord[v] < ot.ord[w] {
+		ot.insert(e)
+		return nil
+	}
+
+	lb, ub := ot.ord[w], ot.ord[v]
+	edgeTo := make(map[int]int)
+
+	// Forward: everything w reaches inside the affected region.
+	var forward []int
+	visited := map[int]bool{w: true}
+	var cycle []int
+
+	var dfsF func(int) bool
+	dfsF = func(x int) bool {
+		forward = append(forward, x)
+		for e := range ot.g.Adjacent(x) {
+			y := e.Other(x)
+			if y == v { // w ~> v plus v -> w
+				for z := x; z != w; z = edgeTo[z] {
+					cycle = append(cycle, z)
+				}
+
+				cycle = append(cycle, w, v)
+				slices.Reverse(cycle)
+				return false
+			}
+
+			if !visited[y] && ot.ord[y] < ub {
+				visited[y] = true
+				edgeTo[y] = x
+				if !dfsF(y) {
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+
+	if !dfsF(w) {
+		return &CycleError{Cycle: cycle}
+	}
+
+	// Backward: everything reaching v inside the affected region.
+	var backward []int
+	visitedB := map[int]bool{v: true}
+
+	var dfsB func(int)
+	dfsB = func(x int) {
+		backward = append(backward, x)
+		for _, y := range ot.radj[x] {
+			if !visitedB[y] && ot.ord[y] > lb {
+				visitedB[y] = true
+				dfsB(y)
+			}
+		}
+	}
+
+	dfsB(v)
+
+	// Backward set goes first, both keeping their relative order.
+	byOrd := func(a, b int) int { return cmp.Compare(ot.ord[a], ot.ord[b]) }
+	slices.SortFunc(forward, byOrd)
+	slices.SortFunc(backward, byOrd)
+
+	var slots []int
+	for _, x := range backward {
+		slots = append(slots, ot.ord[x])
+	}
+
+	for _, x := range forward {
+		slots = append(slots, ot.ord[x])
+	}
+
+	slices.Sort(slots)
+	for i, x := range append(backward, forward...) {
+		ot.ord[x] = slots[i]
+		ot.at[slots[i]] = x
+	}
+
+	ot.insert(e)
+	return nil
+}
+
+func (ot *OnlineTopological) insert(e graph.Edge) {
+	v := e.Head()
+	ot.g.AddEdge(e)
+	ot.radj[e.Other(v)] = append(ot.radj[e.Other(v)], v)
+}
+
+/* Current topological order. */
+func (ot *OnlineTopological) Order() []int {
+	return slices.Clone(ot.at)
+}
+
+/* Position of a vertex in the current order. */
+func (ot *OnlineTopological) Position(v int) int {
+	ot.g.IsVertexOf(v)
+	return ot.ord[v]
+}
+
+/* Edges leaving a vertex, inserted ones included. */
+func (ot *OnlineTopological) Adjacent(v int) iter.Seq[graph.Edge] {
+	return ot.g.Adjacent(v)
+}
+
+/* Every edge of the maintained DAG. */
+func (ot *OnlineTopological) Edges() iter.Seq[graph.Edge] {
+	return ot.g.Edges()
+}
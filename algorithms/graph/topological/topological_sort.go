@@ -5,13 +5,48 @@ package topological
 import (
 	"azure/algorithms/array"
 	"azure/data_structures/graph"
+	"fmt"
+	"strconv"
+	"strings"
 )
 
+/* A Digraph's directed cycle v0 -> v1 -> ... -> v0. */
+type CycleError struct {
+	Cycle []int
+}
+
+func (err *CycleError) Error() string {
+	var sb strings.Builder
+	for _, v := range err.Cycle {
+		sb.WriteString(strconv.Itoa(v))
+		sb.WriteString(" -> ")
+	}
+
+	if len(err.Cycle) > 0 {
+		sb.WriteString(strconv.Itoa(err.Cycle[0]))
+	}
+
+	return fmt.Sprintf("topological: non-acyclical input Digraph: %s", sb.String())
+}
+
 /*
 Topological order of vertices of a Digraph (DFS).
 - Time: O(E + V) & Space: O(V).
 */
-func TopologicalDFS(G *graph.Digraph) []int {
+func TopologicalDFS[VP, EP any](G *graph.DigraphOf[VP, EP]) []int {
+	order, err := TopologicalOrder(G)
+	if err != nil {
+		panic("non-acyclical input Digraph")
+	}
+
+	return order
+}
+
+/*
+Topological order of a Digraph, or one of its cycles as *CycleError.
+- Time: O(E + V) & Space: O(V).
+*/
+func TopologicalOrder[VP, EP any](G *graph.DigraphOf[VP, EP]) ([]int, error) {
 	const (
 		UNMARKED = 0
 		MARKING  = 1
@@ -20,6 +55,8 @@ func TopologicalDFS(G *graph.Digraph) []int {
 
 	order := make([]int, 0, G.V)
 	marked := make([]int, G.V)
+	edgeTo := make([]int, G.V) // DFS tree parent
+	var cycle []int
 
 	var dfs func(int) bool
 	dfs = func(v int) bool {
@@ -30,12 +67,22 @@ func TopologicalDFS(G *graph.Digraph) []int {
 			w := e.Other(v)
 
 			if marked[w] == MARKING { // Cycle detection
+				// Back edge v -> w closes the DFS path w ~> v.
+				for x := v; x != w; x = edgeTo[x] {
+					cycle = append(cycle, x)
+				}
+
+				cycle = append(cycle, w)
+				array.Reverse(cycle)
 				return false
 			}
 
 			// Explore if unmarked + early termination.
-			if marked[w] == UNMARKED && !dfs(w) {
-				return false
+			if marked[w] == UNMARKED {
+				edgeTo[w] = v
+				if !dfs(w) {
+					return false
+				}
 			}
 		}
 
@@ -48,10 +95,10 @@ func TopologicalDFS(G *graph.Digraph) []int {
 
 	for v := range G.V {
 		if marked[v] == UNMARKED && !dfs(v) {
-			panic("non-acyclical input Digraph")
+			return nil, &CycleError{Cycle: cycle}
 		}
 	}
 
 	array.Reverse(order)
-	return order
+	return order, nil
 }
@@ -0,0 +1,143 @@
+/* Algorithm: Transitive Closure & Reduction */
+
+package topological
+
+import (
+	"azure/data_structures/graph"
+	"cmp"
+	"iter"
+	"math/bits"
+	"slices"
+)
+
+type TransitiveClosure struct {
+	V     int
+	reach [][]uint64 // v -> bitset of reachable vertices
+}
+
+/*
+Reachability bitsets of a Digraph (DP on DAG, DFS otherwise).
+- Time: O(V.E/64) on DAG, O(V.(E + V)) otherwise & Space: O(V^2/64).
+*/
+func NewTransitiveClosure(G *graph.Digraph) *TransitiveClosure {
+	words := (G.V + 63) / 64
+	tc := &TransitiveClosure{
+		V:     G.V,
+		reach: make([][]uint64, G.V),
+	}
+
+	for v := range G.V {
+		tc.reach[v] = make([]uint64, words)
+		tc.reach[v][v/64] |= 1 << (v % 64)
+	}
+
+	order, err := TopologicalOrder(G)
+	if err == nil {
+		// Successors first: reach(v) = v + union of reach(w).
+		for i := len(order) - 1; i >= 0; i-- {
+			v := order[i]
+			for e := range G.Adjacent(v) {
+				w := e.Other(v)
+				for k := range words {
+					tc.reach[v][k] |= tc.reach[w][k]
+				}
+			}
+		}
+
+		return tc
+	}
+
+	// Cyclic Digraph -> DFS from every vertex.
+	for s := range G.V {
+		var dfs func(int)
+		dfs = func(v int) {
+			for e := range G.Adjacent(v) {
+				w := e.Other(v)
+				if !tc.Reachable(s, w) {
+					tc.reach[s][w/64] |= 1 << (w % 64)
+					dfs(w)
+				}
+			}
+		}
+
+		dfs(s)
+	}
+
+	return tc
+}
+
+/* Check if w is reachable from v (always true for v itself). */
+func (tc *TransitiveClosure) Reachable(v, w int) bool {
+	tc.IsVertexOf(v)
+	tc.IsVertexOf(w)
+	return tc.reach[v][w/64]&(1<<(w%64)) != 0
+}
+
+/* All vertices reachable from v, in ascending order. */
+func (tc *TransitiveClosure) ReachableFrom(v int) iter.Seq[int] {
+	tc.IsVertexOf(v)
+	return func(yield func(int) bool) {
+		for k, word := range tc.reach[v] {
+			for word != 0 {
+				w := 64*k + bits.TrailingZeros64(word)
+				if !yield(w) {
+					return
+				}
+
+				word &= word - 1
+			}
+		}
+	}
+}
+
+/* Validate if a vertex belongs to a Transitive Closure. */
+func (tc *TransitiveClosure) IsVertexOf(v int) {
+	if v < 0 || v >= tc.V {
+		panic("vertex out of bounds")
+	}
+}
+
+/*
+Fewest-edge DAG with the same reachability as the input DAG.
+- Time: O(V.E/64 + E.logE) & Space: O(V^2/64).
+*/
+func TransitiveReduction(G *graph.Digraph) (*graph.Digraph, error) {
+	order, err := TopologicalOrder(G)
+	if err != nil {
+		return nil, err
+	}
+
+	pos := make([]int, G.V) // vertex -> topological position
+	for i, v := range order {
+		pos[v] = i
+	}
+
+	tc := NewTransitiveClosure(G)
+	R := graph.NewDigraph(G.V)
+	words := (G.V + 63) / 64
+	covered := make([]uint64, words)
+
+	for v := range G.V {
+		children := slices.Collect(G.Adjacent(v))
+		slices.SortFunc(children, func(a, b graph.Edge) int {
+			return cmp.Compare(pos[a.Other(v)], pos[b.Other(v)])
+		})
+
+		clear(covered)
+
+		// Nearest children first: later ones may be implied already.
+		for _, e := range children {
+			w := e.Other(v)
+			if covered[w/64]&(1<<(w%64)) != 0 {
+				continue
+			}
+
+			R.AddEdge(e)
+			for k := range words {
+				covered[k] |= tc.reach[w][k]
+			}
+		}
+	}
+
+	return R, nil
+}
@@ -0,0 +1,56 @@
+/* Algorithm: Graph <-> Digraph Conversion */
+
+package transform
+
+import "azure/data_structures/graph"
+
+/*
+Undirected Graph with 1 edge per directed edge, directions forgotten.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func Symmetrize(G *graph.Digraph) (U *graph.Graph, index []int) {
+	U = graph.NewGraph(G.V)
+	for e := range G.Edges() {
+		U.AddEdge(e)
+	}
+
+	return U, identity(G.V)
+}
+
+/*
+Directed Graph with both directions of every undirected edge, self-loops once.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func Bidirected(G *graph.Graph) (D *graph.Digraph, index []int) {
+	D = graph.NewDigraph(G.V)
+	for e := range G.Edges() {
+		v := e.Head()
+		w := e.Other(v)
+		D.AddEdge(*graph.NewEdge(v, w, e.Weight()))
+		if w != v {
+			D.AddEdge(*graph.NewEdge(w, v, e.Weight()))
+		}
+	}
+
+	return D, identity(G.V)
+}
+
+/*
+Directed Graph orienting every undirected edge away from the endpoint tail picks.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func Orient(G *graph.Graph, tail func(graph.Edge) int) (D *graph.Digraph, index []int) {
+	D = graph.NewDigraph(G.V)
+	for e := range G.Edges() {
+		v := tail(e)
+		D.AddEdge(*graph.NewEdge(v, e.Other(v), e.Weight()))
+	}
+
+	return D, identity(G.V)
+}
+
+/* Orientation rule: from the lower to the higher vertex, acyclic by construction. */
+func LowToHigh(e graph.Edge) int {
+	v := e.Head()
+	return min(v, e.Other(v))
+}
@@ -0,0 +1,172 @@
+/* Algorithm: Union, Complement & Line Graph */
+
+package transform
+
+import (
+	"azure/data_structures/graph"
+	"slices"
+)
+
+/*
+Disjoint union, the vertices of Gs[i] shifted past those of Gs[:i].
+Index[i] maps the vertices of Gs[i] into the union.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func DisjointUnion(Gs ...*graph.Graph) (union *graph.Graph, index [][]int) {
+	V := 0
+	for _, G := range Gs {
+		V += G.V
+	}
+
+	union = graph.NewGraph(V)
+	index = make([][]int, len(Gs))
+	offset := 0
+	for i, G := range Gs {
+		index[i] = shifted(G.V, offset)
+		for e := range G.Edges() {
+			union.AddEdge(*relabel(e, index[i]))
+		}
+
+		offset += G.V
+	}
+
+	return union, index
+}
+
+/*
+Disjoint union of Directed Graphs, the vertices of Gs[i] shifted past those of Gs[:i].
+- Time: O(E + V) & Space: O(E + V).
+*/
+func DirectedDisjointUnion(Gs ...*graph.Digraph) (union *graph.Digraph, index [][]int) {
+	V := 0
+	for _, G := range Gs {
+		V += G.V
+	}
+
+	union = graph.NewDigraph(V)
+	index = make([][]int, len(Gs))
+	offset := 0
+	for i, G := range Gs {
+		index[i] = shifted(G.V, offset)
+		for e := range G.Edges() {
+			union.AddEdge(*relabel(e, index[i]))
+		}
+
+		offset += G.V
+	}
+
+	return union, index
+}
+
+func shifted(V, offset int) []int {
+	index := make([]int, V)
+	for v := range V {
+		index[v] = v + offset
+	}
+
+	return index
+}
+
+/*
+Simple complement: a unit edge between every 2 distinct non-adjacent vertices.
+- Time: O(V^2) & Space: O(V^2).
+*/
+func Complement(G *graph.Graph) (co *graph.Graph, index []int) {
+	adjacent := make([][]bool, G.V)
+	for v := range G.V {
+		adjacent[v] = make([]bool, G.V)
+		for e := range G.Adjacent(v) {
+			adjacent[v][e.Other(v)] = true
+		}
+	}
+
+	co = graph.NewGraph(G.V)
+	for v := range G.V {
+		for w := v + 1; w < G.V; w++ {
+			if !adjacent[v][w] {
+				co.AddEdge(*graph.NewEdge(v, w, 1))
+			}
+		}
+	}
+
+	return co, identity(G.V)
+}
+
+/*
+Simple directed complement: a unit edge v->w for every missing v->w, v != w.
+- Time: O(V^2) & Space: O(V^2).
+*/
+func DirectedComplement(G *graph.Digraph) (co *graph.Digraph, index []int) {
+	adjacent := make([][]bool, G.V)
+	for v := range G.V {
+		adjacent[v] = make([]bool, G.V)
+		for e := range G.Adjacent(v) {
+			adjacent[v][e.Other(v)] = true
+		}
+	}
+
+	co = graph.NewDigraph(G.V)
+	for v := range G.V {
+		for w := range G.V {
+			if v != w && !adjacent[v][w] {
+				co.AddEdge(*graph.NewEdge(v, w, 1))
+			}
+		}
+	}
+
+	return co, identity(G.V)
+}
+
+/*
+Line graph: 1 vertex per edge, unit edges joining edges with a common endpoint.
+Edges lists the original edge behind every new vertex.
+- Time: O(sum of deg^2) & Space: O(E + sum of deg^2).
+*/
+func LineGraph(G *graph.Graph) (L *graph.Graph, edges []graph.Edge) {
+	edges = slices.Collect(G.Edges())
+	incident := make([][]int, G.V)
+	for i, e := range edges {
+		v, w := e.Head(), e.Other(e.Head())
+		incident[v] = append(incident[v], i)
+		if w != v {
+			incident[w] = append(incident[w], i)
+		}
+	}
+
+	// Parallel edges share 2 endpoints but are joined once.
+	L = graph.NewGraph(len(edges))
+	joined := make(map[[2]int]bool)
+	for v := range G.V {
+		for a, i := range incident[v] {
+			for _, j := range incident[v][a+1:] {
+				if !joined[[2]int{i, j}] {
+					joined[[2]int{i, j}] = true
+					L.AddEdge(*graph.NewEdge(i, j, 1))
+				}
+			}
+		}
+	}
+
+	return L, edges
+}
+
+/*
+Directed line graph: 1 vertex per edge, a unit edge from u->v to every v->w.
+- Time: O(sum of indeg.outdeg) & Space: O(E + sum of indeg.outdeg).
+*/
+func DirectedLineGraph(G *graph.Digraph) (L *graph.Digraph, edges []graph.Edge) {
+	outgoing := make([][]int, G.V)
+	for e := range G.Edges() {
+		outgoing[e.Head()] = append(outgoing[e.Head()], len(edges))
+		edges = append(edges, e)
+	}
+
+	L = graph.NewDigraph(len(edges))
+	for i, e := range edges {
+		for _, j := range outgoing[e.Other(e.Head())] {
+			L.AddEdge(*graph.NewEdge(i, j, 1))
+		}
+	}
+
+	return L, edges
+}
@@ -0,0 +1,70 @@
+/* Algorithm: Subgraphs */
+
+package transform
+
+import "azure/data_structures/graph"
+
+/*
+Subgraph induced by vertices, renumbered in their given order.
+Index maps old vertices to new ones, -1 if dropped.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func InducedSubgraph(G *graph.Graph, vertices []int) (sub *graph.Graph, index []int) {
+	index = keepOnly(G.V, vertices)
+	sub = graph.NewGraph(len(vertices))
+	for e := range G.Edges() {
+		v := e.Head()
+		if index[v] != -1 && index[e.Other(v)] != -1 {
+			sub.AddEdge(*relabel(e, index))
+		}
+	}
+
+	return sub, index
+}
+
+/*
+Directed subgraph induced by vertices, renumbered in their given order.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func DirectedInducedSubgraph(G *graph.Digraph, vertices []int) (sub *graph.Digraph, index []int) {
+	index = keepOnly(G.V, vertices)
+	sub = graph.NewDigraph(len(vertices))
+	for e := range G.Edges() {
+		v := e.Head()
+		if index[v] != -1 && index[e.Other(v)] != -1 {
+			sub.AddEdge(*relabel(e, index))
+		}
+	}
+
+	return sub, index
+}
+
+/*
+Spanning subgraph with only the edges satisfying keep.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func FilterEdges(G *graph.Graph, keep func(graph.Edge) bool) (sub *graph.Graph, index []int) {
+	sub = graph.NewGraph(G.V)
+	for e := range G.Edges() {
+		if keep(e) {
+			sub.AddEdge(e)
+		}
+	}
+
+	return sub, identity(G.V)
+}
+
+/*
+Spanning directed subgraph with only the edges satisfying keep.
+- Time: O(E + V) & Space: O(E + V).
+*/
+func DirectedFilterEdges(G *graph.Digraph, keep func(graph.Edge) bool) (sub *graph.Digraph, index []int) {
+	sub = graph.NewDigraph(G.V)
+	for e := range G.Edges() {
+		if keep(e) {
+			sub.AddEdge(e)
+		}
+	}
+
+	return sub, identity(G.V)
+}
@@ -0,0 +1,42 @@
+/* API: Graph Transformations */
+
+package transform
+
+import "azure/data_structures/graph"
+
+/* Copy of e between the new indices of its endpoints. */
+func relabel(e graph.Edge, index []int) *graph.Edge {
+	v := e.Head()
+	return graph.NewEdge(index[v], index[e.Other(v)], e.Weight())
+}
+
+func identity(V int) []int {
+	index := make([]int, V)
+	for v := range V {
+		index[v] = v
+	}
+
+	return index
+}
+
+/* Old -> new index for the kept vertices in given order, -1 elsewhere. */
+func keepOnly(V int, vertices []int) []int {
+	index := make([]int, V)
+	for v := range V {
+		index[v] = -1
+	}
+
+	for i, v := range vertices {
+		if v < 0 || v >= V {
+			panic("vertex out of bounds")
+		}
+
+		if index[v] != -1 {
+			panic("duplicate vertex")
+		}
+
+		index[v] = i
+	}
+
+	return index
+}
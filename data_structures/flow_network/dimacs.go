@@ -0,0 +1,93 @@
+/* API: DIMACS Maxflow Format */
+
+package graph
+
+import (
+	"azure/internal/dimacs"
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Shared with the shortest path format, so errors.Is() matches both.
+var ErrMalformedDIMACS = dimacs.ErrMalformed
+
+/* Create a Flow Network & its s-t pair from a DIMACS 'p max' stream. */
+func NewFlowNetworkDIMACS(r io.Reader) (*FlowNetwork, int, int, error) {
+	var G *FlowNetwork
+	s, t, E := -1, -1, 0
+
+	scanErr := dimacs.Scan(r, func(line int, fields []string) error {
+		switch fields[0] {
+		case "p":
+			nums, parseErr := dimacs.Ints(fields, 2, 2)
+			if parseErr != nil || G != nil || fields[1] != "max" || nums[0] < 0 || nums[1] < 0 {
+				return fmt.Errorf("%w: line %d: bad problem line", ErrMalformedDIMACS, line)
+			}
+
+			G = NewFlowNetwork(nums[0])
+			E = nums[1]
+		case "n":
+			if len(fields) != 3 || G == nil {
+				return fmt.Errorf("%w: line %d: bad node line", ErrMalformedDIMACS, line)
+			}
+
+			v, parseErr := strconv.Atoi(fields[1])
+			if parseErr != nil || !G.hasVertex(v-1) {
+				return fmt.Errorf("%w: line %d: bad node line", ErrMalformedDIMACS, line)
+			}
+
+			switch fields[2] {
+			case "s":
+				s = v - 1
+			case "t":
+				t = v - 1
+			default:
+				return fmt.Errorf("%w: line %d: bad node line", ErrMalformedDIMACS, line)
+			}
+		case "a":
+			nums, parseErr := dimacs.Ints(fields, 1, 3)
+			if parseErr != nil || G == nil || !G.hasVertex(nums[0]-1) || !G.hasVertex(nums[1]-1) || nums[2] < 0 {
+				return fmt.Errorf("%w: line %d: bad arc line", ErrMalformedDIMACS, line)
+			}
+
+			G.AddEdge(*NewFlowEdge(nums[0]-1, nums[1]-1, nums[2]))
+		default:
+			return fmt.Errorf("%w: line %d: unknown descriptor", ErrMalformedDIMACS, line)
+		}
+
+		return nil
+	})
+
+	if scanErr != nil {
+		return nil, -1, -1, scanErr
+	}
+
+	if G == nil || G.E != E || s == -1 || t == -1 {
+		return nil, -1, -1, fmt.Errorf("%w: missing source, sink or arcs", ErrMalformedDIMACS)
+	}
+
+	return G, s, t, nil
+}
+
+/* Write the Flow Network & its s-t pair as a DIMACS 'p max' stream. */
+func (G *FlowNetwork) WriteDIMACS(w io.Writer, s, t int) error {
+	G.IsVertexOf(s)
+	G.IsVertexOf(t)
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "p max %d %d\n", G.V, G.E)
+	fmt.Fprintf(out, "n %d s\n", s+1)
+	fmt.Fprintf(out, "n %d t\n", t+1)
+
+	for e := range G.Edges() {
+		fmt.Fprintf(out, "a %d %d %d\n", e.from+1, e.to+1, e.cap)
+	}
+
+	return out.Flush()
+}
+
+func (G *FlowNetwork) hasVertex(v int) bool {
+	return v >= 0 && v < G.V
+}
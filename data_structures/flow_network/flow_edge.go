@@ -5,11 +5,17 @@ package graph
 type FlowEdge struct {
 	from, to  int
 	flow, cap int
+	cost      int
 }
 
 /* Create a new hollow Directed Flow Edge. */
 func NewFlowEdge(v, w, cap int) *FlowEdge {
-	return &FlowEdge{v, w, 0, cap}
+	return &FlowEdge{v, w, 0, cap, 0}
+}
+
+/* Create a new hollow Directed Flow Edge with per-unit cost. */
+func NewCostFlowEdge(v, w, cap, cost int) *FlowEdge {
+	return &FlowEdge{v, w, 0, cap, cost}
 }
 
 /* The head endpoint of the Flow Edge. */
@@ -29,6 +35,21 @@ func (e *FlowEdge) Other(v int) int {
 	panic("invalid edge endpoint")
 }
 
+/* Current amount of flow carried by a Flow Edge. */
+func (e *FlowEdge) Flow() int {
+	return e.flow
+}
+
+/* Maximum amount of flow a Flow Edge can carry. */
+func (e *FlowEdge) Capacity() int {
+	return e.cap
+}
+
+/* Cost of sending one unit of flow through a Flow Edge. */
+func (e *FlowEdge) Cost() int {
+	return e.cost
+}
+
 /* Add an amount of residual to a Flow Edge. */
 func (e *FlowEdge) AddResidualTo(v int, delta int) {
 	switch v {
@@ -45,13 +66,13 @@ func (e *FlowEdge) AddResidualTo(v int, delta int) {
 	}
 }
 
-/* Residual value corresponding to a vertex in a Flow Edge. */
-func (e *FlowEdge) ResidualTo(v int) int {
+/* Residual capacity towards a vertex in a Flow Edge. */
+func (e *FlowEdge) ResidualCapacityTo(v int) int {
 	switch v {
-	case e.from:
-		return e.to
-	case e.to:
-		return e.from
+	case e.from: // Backward edge
+		return e.flow
+	case e.to: // Forward edge
+		return e.cap - e.flow
 	}
 
 	panic("invalid edge endpoint")
@@ -4,11 +4,18 @@ package graph
 
 import (
 	"bufio"
+	"errors"
+	"fmt"
 	"io"
 	"iter"
 	"strconv"
 )
 
+var (
+	ErrInfeasibleFlow  = errors.New("flow network: flow violates edge capacity")
+	ErrUnconservedFlow = errors.New("flow network: flow violates conservation")
+)
+
 type FlowNetwork struct {
 	E, V int
 	adj  [][]*FlowEdge
@@ -63,14 +70,14 @@ func NewFlowNetworkIO(r io.Reader) *FlowNetwork {
 		from := readInt()
 		to := readInt()
 		cap := readInt()
-		G.AddEdge(FlowEdge{from, to, 0, cap})
+		G.AddEdge(*NewFlowEdge(from, to, cap))
 	}
 
 	return G
 }
 
-/* Add a flow edge onto the Flow Network. */
-func (G *FlowNetwork) AddEdge(e FlowEdge) {
+/* Add a flow edge onto the Flow Network, return the stored edge. */
+func (G *FlowNetwork) AddEdge(e FlowEdge) *FlowEdge {
 	v, w := e.from, e.to
 	G.IsVertexOf(v)
 	G.IsVertexOf(w)
@@ -78,20 +85,82 @@ func (G *FlowNetwork) AddEdge(e FlowEdge) {
 	G.adj[v] = append(G.adj[v], &e)
 	G.adj[w] = append(G.adj[w], &e)
 	G.E++
+
+	return &e
 }
 
 /* Adjacency List (flow edges) of a given vertex. */
-func (G *FlowNetwork) Adjacent(v int) iter.Seq[FlowEdge] {
+func (G *FlowNetwork) Adjacent(v int) iter.Seq[*FlowEdge] {
 	G.IsVertexOf(v)
-	return func(yield func(FlowEdge) bool) {
+	return func(yield func(*FlowEdge) bool) {
 		for _, e := range G.adj[v] {
-			if !yield(*e) {
+			if !yield(e) {
 				return
 			}
 		}
 	}
 }
 
+/* All flow edges from a Flow Network, self-loops included once. */
+func (G *FlowNetwork) Edges() iter.Seq[*FlowEdge] {
+	return func(yield func(*FlowEdge) bool) {
+		for v := range G.V {
+			for i, e := range G.adj[v] {
+				// Self-loop is stored twice in a row, keep the 1st.
+				if e.from != v || e.to == v && i > 0 && G.adj[v][i-1] == e {
+					continue
+				}
+
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+/* Net flow out of a vertex (outflow - inflow). */
+func (G *FlowNetwork) NetFlowOf(v int) int {
+	G.IsVertexOf(v)
+
+	net := 0
+	for _, e := range G.adj[v] {
+		if e.from == v {
+			net += e.flow
+		}
+
+		if e.to == v {
+			net -= e.flow
+		}
+	}
+
+	return net
+}
+
+/* Check capacity & conservation constraints of current s-t flow. */
+func (G *FlowNetwork) CheckFlow(s, t int) error {
+	G.IsVertexOf(s)
+	G.IsVertexOf(t)
+
+	for e := range G.Edges() {
+		if e.flow < 0 || e.flow > e.cap {
+			return fmt.Errorf("%w: edge %d->%d", ErrInfeasibleFlow, e.from, e.to)
+		}
+	}
+
+	for v := range G.V {
+		if v != s && v != t && G.NetFlowOf(v) != 0 {
+			return fmt.Errorf("%w: vertex %d", ErrUnconservedFlow, v)
+		}
+	}
+
+	if G.NetFlowOf(s) != -G.NetFlowOf(t) {
+		return fmt.Errorf("%w: source %d & sink %d", ErrUnconservedFlow, s, t)
+	}
+
+	return nil
+}
+
 /* Validate if a vertex belongs to a Flow Network. */
 func (G *FlowNetwork) IsVertexOf(v int) {
 	if v < 0 || v >= G.V {
@@ -0,0 +1,223 @@
+/* Data Structure: Concurrent Graphs (Copy-on-Write Snapshots) */
+
+package graph
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+Undirected Graph shared between goroutines. Readers take snapshots without
+locking; writers build a new version that shares every untouched adjacency
+list with the old one, then publish it atomically.
+*/
+type ConcurrentGraph struct {
+	mu      sync.Mutex // serializes writers only
+	current atomic.Pointer[Graph]
+}
+
+/* Pending changes of one write, on top of the latest version. */
+type GraphBatch struct {
+	g     *Graph
+	owned map[int]bool // adjacency lists already copied by this batch
+}
+
+/* Wrap a copy of an Undirected Graph, later changes to G aren't seen. */
+func NewConcurrentGraph(G *Graph) *ConcurrentGraph {
+	C := &ConcurrentGraph{}
+	next := G.shallowCopy()
+	for v := range next.V {
+		next.adj[v] = slices.Clone(next.adj[v])
+	}
+
+	C.current.Store(next)
+	return C
+}
+
+/*
+Latest consistent version, never modified afterwards so it must be treated as read-only.
+- Time: O(1).
+*/
+func (C *ConcurrentGraph) Snapshot() *Graph {
+	return C.current.Load()
+}
+
+/*
+Apply several changes as 1 atomic version.
+- Time: O(V + sum of touched degrees).
+*/
+func (C *ConcurrentGraph) Update(edit func(*GraphBatch)) {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+
+	b := &GraphBatch{g: C.current.Load().shallowCopy(), owned: make(map[int]bool)}
+	edit(b)
+	C.current.Store(b.g)
+}
+
+/* Add an edge as its own version. */
+func (C *ConcurrentGraph) AddEdge(e Edge) {
+	C.Update(func(b *GraphBatch) { b.AddEdge(e) })
+}
+
+/* Remove 1 copy of an edge as its own version, false if absent. */
+func (C *ConcurrentGraph) RemoveEdge(e Edge) bool {
+	removed := false
+	C.Update(func(b *GraphBatch) { removed = b.RemoveEdge(e) })
+	return removed
+}
+
+func (b *GraphBatch) AddEdge(e Edge) {
+	b.g.IsVertexOf(e.v)
+	b.g.IsVertexOf(e.w)
+	b.own(e.v)
+	b.own(e.w)
+	b.g.AddEdge(e)
+}
+
+/* Remove 1 copy of an edge, stored either way round. */
+func (b *GraphBatch) RemoveEdge(e Edge) bool {
+	b.g.IsVertexOf(e.v)
+	b.g.IsVertexOf(e.w)
+	if !slices.Contains(b.g.adj[e.v], e) {
+		e.v, e.w = e.w, e.v
+		if !slices.Contains(b.g.adj[e.v], e) {
+			return false
+		}
+	}
+
+	b.own(e.v)
+	b.own(e.w)
+	for _, v := range []int{e.v, e.w} {
+		i := slices.Index(b.g.adj[v], e)
+		b.g.adj[v] = slices.Delete(b.g.adj[v], i, i+1)
+		b.g.deg[v]--
+	}
+
+	b.g.E--
+	return true
+}
+
+/* Copy v's adjacency before its 1st change in this batch. */
+func (b *GraphBatch) own(v int) {
+	if !b.owned[v] {
+		b.owned[v] = true
+		b.g.adj[v] = slices.Clone(b.g.adj[v])
+	}
+}
+
+/* New header & degree arrays, adjacency lists still shared. */
+func (G *GraphOf[VP, EP]) shallowCopy() *GraphOf[VP, EP] {
+	return &GraphOf[VP, EP]{
+		E:        G.E,
+		V:        G.V,
+		adj:      slices.Clone(G.adj),
+		deg:      slices.Clone(G.deg),
+		vertices: slices.Clone(G.vertices),
+	}
+}
+
+/*
+Directed Graph shared between goroutines, with the same copy-on-write
+snapshots as ConcurrentGraph.
+*/
+type ConcurrentDigraph struct {
+	mu      sync.Mutex // serializes writers only
+	current atomic.Pointer[Digraph]
+}
+
+/* Pending changes of one write, on top of the latest version. */
+type DigraphBatch struct {
+	g     *Digraph
+	owned map[int]bool // adjacency lists already copied by this batch
+}
+
+/* Wrap a copy of a Directed Graph, later changes to G aren't seen. */
+func NewConcurrentDigraph(G *Digraph) *ConcurrentDigraph {
+	C := &ConcurrentDigraph{}
+	next := G.shallowCopy()
+	for v := range next.V {
+		next.adj[v] = slices.Clone(next.adj[v])
+	}
+
+	C.current.Store(next)
+	return C
+}
+
+/*
+Latest consistent version, never modified afterwards so it must be treated as read-only.
+- Time: O(1).
+*/
+func (C *ConcurrentDigraph) Snapshot() *Digraph {
+	return C.current.Load()
+}
+
+/*
+Apply several changes as 1 atomic version.
+- Time: O(V + sum of touched outdegrees).
+*/
+func (C *ConcurrentDigraph) Update(edit func(*DigraphBatch)) {
+	C.mu.Lock()
+	defer C.mu.Unlock()
+
+	b := &DigraphBatch{g: C.current.Load().shallowCopy(), owned: make(map[int]bool)}
+	edit(b)
+	C.current.Store(b.g)
+}
+
+/* Add an edge as its own version. */
+func (C *ConcurrentDigraph) AddEdge(e Edge) {
+	C.Update(func(b *DigraphBatch) { b.AddEdge(e) })
+}
+
+/* Remove 1 copy of an edge as its own version, false if absent. */
+func (C *ConcurrentDigraph) RemoveEdge(e Edge) bool {
+	removed := false
+	C.Update(func(b *DigraphBatch) { removed = b.RemoveEdge(e) })
+	return removed
+}
+
+func (b *DigraphBatch) AddEdge(e Edge) {
+	b.g.IsVertexOf(e.v)
+	b.g.IsVertexOf(e.w)
+	b.own(e.v)
+	b.g.AddEdge(e)
+}
+
+func (b *DigraphBatch) RemoveEdge(e Edge) bool {
+	b.g.IsVertexOf(e.v)
+	b.g.IsVertexOf(e.w)
+	i := slices.Index(b.g.adj[e.v], e)
+	if i == -1 {
+		return false
+	}
+
+	b.own(e.v)
+	b.g.adj[e.v] = slices.Delete(b.g.adj[e.v], i, i+1)
+	b.g.outdeg[e.v]--
+	b.g.indeg[e.w]--
+	b.g.E--
+	return true
+}
+
+/* Copy v's adjacency before its 1st change in this batch. */
+func (b *DigraphBatch) own(v int) {
+	if !b.owned[v] {
+		b.owned[v] = true
+		b.g.adj[v] = slices.Clone(b.g.adj[v])
+	}
+}
+
+/* New header & degree arrays, adjacency lists still shared. */
+func (G *DigraphOf[VP, EP]) shallowCopy() *DigraphOf[VP, EP] {
+	return &DigraphOf[VP, EP]{
+		E:        G.E,
+		V:        G.V,
+		adj:      slices.Clone(G.adj),
+		outdeg:   slices.Clone(G.outdeg),
+		indeg:    slices.Clone(G.indeg),
+		vertices: slices.Clone(G.vertices),
+	}
+}
@@ -0,0 +1,47 @@
+package graph
+
+import "testing"
+
+func TestConcurrentGraphRemoveEdgeEitherWay(t *testing.T) {
+	G := NewGraph(3)
+	G.AddEdge(*NewEdge(0, 1, 5))
+	G.AddEdge(*NewEdge(1, 2, 7))
+	C := NewConcurrentGraph(G)
+	before := C.Snapshot()
+
+	if !C.RemoveEdge(*NewEdge(1, 0, 5)) {
+		t.Fatal("RemoveEdge(1-0) = false, want the stored 0-1 edge removed")
+	}
+
+	after := C.Snapshot()
+	if after.E != 1 || after.Degree(0) != 0 || after.Degree(1) != 1 {
+		t.Errorf("got E=%d deg(0)=%d deg(1)=%d, want 1, 0, 1",
+			after.E, after.Degree(0), after.Degree(1))
+	}
+
+	if before.E != 2 || before.Degree(0) != 1 {
+		t.Errorf("older snapshot changed: E=%d deg(0)=%d", before.E, before.Degree(0))
+	}
+
+	if C.RemoveEdge(*NewEdge(1, 0, 5)) {
+		t.Error("RemoveEdge(1-0) = true on a removed edge")
+	}
+
+	if C.RemoveEdge(*NewEdge(2, 1, 8)) {
+		t.Error("RemoveEdge(2-1) = true with another weight")
+	}
+}
+
+func TestConcurrentGraphRemoveSelfLoop(t *testing.T) {
+	G := NewGraph(2)
+	G.AddEdge(*NewEdge(1, 1, 3))
+	C := NewConcurrentGraph(G)
+
+	if !C.RemoveEdge(*NewEdge(1, 1, 3)) {
+		t.Fatal("RemoveEdge(1-1) = false, want the self-loop removed")
+	}
+
+	if S := C.Snapshot(); S.E != 0 || S.Degree(1) != 0 {
+		t.Errorf("got E=%d deg(1)=%d, want 0, 0", S.E, S.Degree(1))
+	}
+}
@@ -0,0 +1,110 @@
+/* Data Structure: Compressed Sparse Row Graph */
+
+package graph
+
+import (
+	"encoding/binary"
+	"iter"
+)
+
+/*
+Read-only view over the varint adjacency of the binary format, decoded on
+the fly: only a byte offset per vertex lives outside the encoded data.
+Undirected graphs list each edge under both endpoints.
+*/
+type CSR struct {
+	E, V     int
+	Directed bool
+	data     []byte       // encoded file, possibly memory-mapped
+	offsets  []int        // vertex -> start of its adjacency in data
+	release  func() error // unmaps data, nil if on the heap
+}
+
+/* Adjacency List (edges) of a given vertex, each edge starting from it. */
+func (C *CSR) Adjacent(v int) iter.Seq[Edge] {
+	C.IsVertexOf(v)
+	return func(yield func(Edge) bool) {
+		// Entries were validated by ParseCSR, so decoding can't fail.
+		n, at := binary.Uvarint(C.data[C.offsets[v]:])
+		at += C.offsets[v]
+		for range n {
+			w, dw := binary.Varint(C.data[at:])
+			weight, dweight := binary.Varint(C.data[at+dw:])
+			at += dw + dweight
+
+			if !yield(*NewEdge(v, v+int(w), int(weight))) {
+				return
+			}
+		}
+	}
+}
+
+/* Outdegree, or degree if undirected. */
+func (C *CSR) Degree(v int) int {
+	C.IsVertexOf(v)
+	n, _ := binary.Uvarint(C.data[C.offsets[v]:])
+	return int(n)
+}
+
+/* All edges, undirected ones (self-loops included) once. */
+func (C *CSR) Edges() iter.Seq[Edge] {
+	if !C.Directed {
+		return undirectedEdges(C.V, C.Adjacent)
+	}
+
+	return func(yield func(Edge) bool) {
+		for v := range C.V {
+			for e := range C.Adjacent(v) {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+/* Unpack into a mutable Undirected Graph. */
+func (C *CSR) Graph() *Graph {
+	if C.Directed {
+		panic("not an undirected graph")
+	}
+
+	G := NewGraph(C.V)
+	for e := range C.Edges() {
+		G.AddEdge(e)
+	}
+
+	return G
+}
+
+/* Unpack into a mutable Directed Graph. */
+func (C *CSR) Digraph() *Digraph {
+	if !C.Directed {
+		panic("not a directed graph")
+	}
+
+	G := NewDigraph(C.V)
+	for e := range C.Edges() {
+		G.AddEdge(e)
+	}
+
+	return G
+}
+
+/* Release the memory mapping behind the view, which is unusable afterwards. */
+func (C *CSR) Close() error {
+	if C.release == nil {
+		return nil
+	}
+
+	release := C.release
+	C.release, C.data, C.offsets = nil, nil, nil
+	return release()
+}
+
+/* Validate if a vertex belongs to a CSR Graph. */
+func (C *CSR) IsVertexOf(v int) {
+	if v < 0 || v >= C.V {
+		panic("vertex out of bounds")
+	}
+}
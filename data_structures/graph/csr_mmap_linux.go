@@ -0,0 +1,46 @@
+//go:build linux
+
+package graph
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+/*
+Load a binary graph file into a CSR view over a read-only memory mapping,
+so edges are decoded from the page cache instead of copied. Close() the
+view to unmap the file.
+*/
+func OpenCSR(path string) (*CSR, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Size() == 0 {
+		return nil, fmt.Errorf("%w: empty file", ErrMalformedBinary)
+	}
+
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+
+	C, err := ParseCSR(data)
+	if err != nil {
+		syscall.Munmap(data)
+		return nil, err
+	}
+
+	C.release = func() error { return syscall.Munmap(data) }
+	return C, nil
+}
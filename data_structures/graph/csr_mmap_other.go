@@ -0,0 +1,15 @@
+//go:build !linux
+
+package graph
+
+import "os"
+
+/* Load a binary graph file into a CSR view, reading it whole (no mapping). */
+func OpenCSR(path string) (*CSR, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseCSR(data)
+}
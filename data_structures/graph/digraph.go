@@ -9,25 +9,35 @@ import (
 	"strconv"
 )
 
-type Digraph struct {
-	E, V   int
-	adj    [][]Edge
-	outdeg []int
-	indeg  []int
+/* Directed Graph with vertex payloads VP & edge payloads EP. */
+type DigraphOf[VP, EP any] struct {
+	E, V     int
+	adj      [][]EdgeOf[EP]
+	outdeg   []int
+	indeg    []int
+	vertices []VP
 }
 
+type Digraph = DigraphOf[struct{}, struct{}]
+
 /* Create a Directed Graph with V vertices. */
 func NewDigraph(V int) *Digraph {
+	return NewDigraphOf[struct{}, struct{}](V)
+}
+
+/* Create a Directed Graph with V zero-payload vertices. */
+func NewDigraphOf[VP, EP any](V int) *DigraphOf[VP, EP] {
 	if V < 0 {
 		panic("negative number of vertices")
 	}
 
-	return &Digraph{
-		E:      0,
-		V:      V,
-		adj:    make([][]Edge, V),
-		outdeg: make([]int, V),
-		indeg:  make([]int, V),
+	return &DigraphOf[VP, EP]{
+		E:        0,
+		V:        V,
+		adj:      make([][]EdgeOf[EP], V),
+		outdeg:   make([]int, V),
+		indeg:    make([]int, V),
+		vertices: make([]VP, V),
 	}
 }
 
@@ -54,10 +64,7 @@ func NewDigraphIO(r io.Reader) *Digraph {
 		panic("negative number of vertices")
 	}
 
-	G := &Digraph{
-		V:   V,
-		adj: make([][]Edge, V),
-	}
+	G := NewDigraph(V)
 
 	E := readInt()
 	if E < 0 {
@@ -68,14 +75,14 @@ func NewDigraphIO(r io.Reader) *Digraph {
 		from := readInt()
 		to := readInt()
 		weight := readInt()
-		G.AddEdge(Edge{from, to, weight})
+		G.AddEdge(*NewEdge(from, to, weight))
 	}
 
 	return G
 }
 
 /* Add an edge onto the Directed Graph. */
-func (G *Digraph) AddEdge(e Edge) {
+func (G *DigraphOf[VP, EP]) AddEdge(e EdgeOf[EP]) {
 	from, to := e.v, e.w
 	G.IsVertexOf(from)
 	G.IsVertexOf(to)
@@ -87,9 +94,9 @@ func (G *Digraph) AddEdge(e Edge) {
 }
 
 /* Adjacency List (edges) of a given vertex. */
-func (G *Digraph) Adjacent(v int) iter.Seq[Edge] {
+func (G *DigraphOf[VP, EP]) Adjacent(v int) iter.Seq[EdgeOf[EP]] {
 	G.IsVertexOf(v)
-	return func(yield func(Edge) bool) {
+	return func(yield func(EdgeOf[EP]) bool) {
 		N := len(G.adj[v])
 		for i := range N {
 			if !yield(G.adj[v][i]) {
@@ -100,8 +107,8 @@ func (G *Digraph) Adjacent(v int) iter.Seq[Edge] {
 }
 
 /* All directed edges from a Directed Graph. */
-func (G *Digraph) Edges() iter.Seq[Edge] {
-	return func(yield func(Edge) bool) {
+func (G *DigraphOf[VP, EP]) Edges() iter.Seq[EdgeOf[EP]] {
+	return func(yield func(EdgeOf[EP]) bool) {
 		for v := range G.V {
 			for e := range G.Adjacent(v) {
 				if !yield(e) {
@@ -113,19 +120,19 @@ func (G *Digraph) Edges() iter.Seq[Edge] {
 }
 
 /* Indegree of a Directed Graph's vertex. */
-func (G *Digraph) Indegree(v int) int {
+func (G *DigraphOf[VP, EP]) Indegree(v int) int {
 	G.IsVertexOf(v)
 	return G.indeg[v]
 }
 
 /* Outdegree of a Directed Graph's vertex. */
-func (G *Digraph) Outdegree(v int) int {
+func (G *DigraphOf[VP, EP]) Outdegree(v int) int {
 	G.IsVertexOf(v)
 	return G.outdeg[v]
 }
 
 /* All reachable vertices from vertex 'v'. */
-func (G *Digraph) Reachable(v int) iter.Seq[int] {
+func (G *DigraphOf[VP, EP]) Reachable(v int) iter.Seq[int] {
 	return func(yield func(int) bool) {
 		marked := make([]bool, G.V)
 
@@ -147,21 +154,19 @@ func (G *Digraph) Reachable(v int) iter.Seq[int] {
 }
 
 /* Make a reversed clone of a Directed Graph. */
-func (G *Digraph) Reversed() *Digraph {
-	G_R := &Digraph{
-		V:   G.V,
-		adj: make([][]Edge, G.V),
-	}
+func (G *DigraphOf[VP, EP]) Reversed() *DigraphOf[VP, EP] {
+	G_R := NewDigraphOf[VP, EP](G.V)
+	copy(G_R.vertices, G.vertices)
 
 	for e := range G.Edges() {
-		G_R.AddEdge(Edge{e.w, e.v, e.weight})
+		G_R.AddEdge(EdgeOf[EP]{e.payload, e.w, e.v, e.weight})
 	}
 
 	return G_R
 }
 
 /* Traverse the Directed Graph in Preorder fashion. */
-func (G *Digraph) PreOrder() iter.Seq[int] {
+func (G *DigraphOf[VP, EP]) PreOrder() iter.Seq[int] {
 	return func(yield func(int) bool) {
 		marked := make([]bool, G.V)
 
@@ -189,7 +194,7 @@ func (G *Digraph) PreOrder() iter.Seq[int] {
 }
 
 /* Traverse the Directed Graph in Postorder fashion. */
-func (G *Digraph) PostOrder() iter.Seq[int] {
+func (G *DigraphOf[VP, EP]) PostOrder() iter.Seq[int] {
 	return func(yield func(int) bool) {
 		marked := make([]bool, G.V)
 
@@ -217,8 +222,20 @@ func (G *Digraph) PostOrder() iter.Seq[int] {
 	}
 }
 
+/* Payload of a vertex. */
+func (G *DigraphOf[VP, EP]) Vertex(v int) VP {
+	G.IsVertexOf(v)
+	return G.vertices[v]
+}
+
+/* Attach a payload to a vertex. */
+func (G *DigraphOf[VP, EP]) SetVertex(v int, payload VP) {
+	G.IsVertexOf(v)
+	G.vertices[v] = payload
+}
+
 /* Validate if a vertex belongs to a Directed Graph. */
-func (G *Digraph) IsVertexOf(v int) {
+func (G *DigraphOf[VP, EP]) IsVertexOf(v int) {
 	if v < 0 || v >= G.V {
 		panic("vertex out of bounds")
 	}
@@ -0,0 +1,132 @@
+/* API: DIMACS Shortest Path Format */
+
+package graph
+
+import (
+	"azure/internal/dimacs"
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+)
+
+// Shared with the maxflow format, so errors.Is() matches both.
+var ErrMalformedDIMACS = dimacs.ErrMalformed
+
+type Coordinate struct {
+	X, Y int
+}
+
+/* Create a Directed Graph from a DIMACS '.gr' stream ('p sp'). */
+func NewDigraphDIMACS(r io.Reader) (*Digraph, error) {
+	var G *Digraph
+	E := 0
+
+	scanErr := dimacs.Scan(r, func(line int, fields []string) error {
+		switch fields[0] {
+		case "p":
+			nums, parseErr := dimacs.Ints(fields, 2, 2)
+			if parseErr != nil || G != nil || fields[1] != "sp" || nums[0] < 0 || nums[1] < 0 {
+				return fmt.Errorf("%w: line %d: bad problem line", ErrMalformedDIMACS, line)
+			}
+
+			G = NewDigraph(nums[0])
+			E = nums[1]
+		case "a":
+			nums, parseErr := dimacs.Ints(fields, 1, 3)
+			if parseErr != nil || G == nil || !G.hasVertex(nums[0]-1) || !G.hasVertex(nums[1]-1) {
+				return fmt.Errorf("%w: line %d: bad arc line", ErrMalformedDIMACS, line)
+			}
+
+			G.AddEdge(*NewEdge(nums[0]-1, nums[1]-1, nums[2]))
+		default:
+			return fmt.Errorf("%w: line %d: unknown descriptor", ErrMalformedDIMACS, line)
+		}
+
+		return nil
+	})
+
+	if scanErr != nil {
+		return nil, scanErr
+	}
+
+	if G == nil || G.E != E {
+		return nil, fmt.Errorf("%w: arc count mismatch", ErrMalformedDIMACS)
+	}
+
+	return G, nil
+}
+
+/* Write the Directed Graph as a DIMACS '.gr' stream ('p sp'). */
+func (G *DigraphOf[VP, EP]) WriteDIMACS(w io.Writer) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "p sp %d %d\n", G.V, G.E)
+
+	for e := range G.Edges() {
+		fmt.Fprintf(out, "a %d %d %d\n", e.v+1, e.w+1, e.weight)
+	}
+
+	return out.Flush()
+}
+
+/* Read vertex coordinates from a DIMACS '.co' stream. */
+func ReadDIMACSCoordinates(r io.Reader) ([]Coordinate, error) {
+	var coords []Coordinate
+	var seen []bool
+
+	scanErr := dimacs.Scan(r, func(line int, fields []string) error {
+		switch fields[0] {
+		case "p":
+			if len(fields) != 5 || fields[1] != "aux" || fields[2] != "sp" || fields[3] != "co" {
+				return fmt.Errorf("%w: line %d: bad problem line", ErrMalformedDIMACS, line)
+			}
+
+			N, parseErr := strconv.Atoi(fields[4])
+			if parseErr != nil || coords != nil || N < 0 {
+				return fmt.Errorf("%w: line %d: bad problem line", ErrMalformedDIMACS, line)
+			}
+
+			coords = make([]Coordinate, N)
+			seen = make([]bool, N)
+		case "v":
+			nums, parseErr := dimacs.Ints(fields, 1, 3)
+			if parseErr != nil || nums[0] < 1 || nums[0] > len(coords) || seen[nums[0]-1] {
+				return fmt.Errorf("%w: line %d: bad vertex line", ErrMalformedDIMACS, line)
+			}
+
+			coords[nums[0]-1] = Coordinate{nums[1], nums[2]}
+			seen[nums[0]-1] = true
+		default:
+			return fmt.Errorf("%w: line %d: unknown descriptor", ErrMalformedDIMACS, line)
+		}
+
+		return nil
+	})
+
+	if scanErr != nil {
+		return nil, scanErr
+	}
+
+	if coords == nil || slices.Contains(seen, false) {
+		return nil, fmt.Errorf("%w: vertex count mismatch", ErrMalformedDIMACS)
+	}
+
+	return coords, nil
+}
+
+/* Write vertex coordinates as a DIMACS '.co' stream. */
+func WriteDIMACSCoordinates(w io.Writer, coords []Coordinate) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "p aux sp co %d\n", len(coords))
+
+	for v, c := range coords {
+		fmt.Fprintf(out, "v %d %d %d\n", v+1, c.X, c.Y)
+	}
+
+	return out.Flush()
+}
+
+func (G *DigraphOf[VP, EP]) hasVertex(v int) bool {
+	return v >= 0 && v < G.V
+}
@@ -2,26 +2,35 @@
 
 package graph
 
-type Edge struct {
-	v, w   int
-	weight int
+/* Edge carrying a payload of type P besides its weight. */
+type EdgeOf[P any] struct {
+	payload P // first, so a zero-size payload adds no padding
+	v, w    int
+	weight  int
 }
 
+type Edge = EdgeOf[struct{}]
+
 /* Create a general-purpose Graph edge. */
 func NewEdge(u, v, weight int) *Edge {
-	return &Edge{u, v, weight}
+	return &Edge{v: u, w: v, weight: weight}
+}
+
+/* Create a Graph edge with a payload. */
+func NewEdgeOf[P any](u, v, weight int, payload P) *EdgeOf[P] {
+	return &EdgeOf[P]{payload, u, v, weight}
 }
 
 /*
 Arbitrary endpoint for Undirected Edge;
 Source endpoint for Directed Edge.
 */
-func (e *Edge) Head() int {
+func (e *EdgeOf[P]) Head() int {
 	return e.v
 }
 
 /* Another endpoint of a vertex in an Edge. */
-func (e *Edge) Other(v int) int {
+func (e *EdgeOf[P]) Other(v int) int {
 	switch v {
 	case e.v:
 		return e.w
@@ -33,6 +42,11 @@ func (e *Edge) Other(v int) int {
 }
 
 /* An Edge's weight. */
-func (e *Edge) Weight() int {
+func (e *EdgeOf[P]) Weight() int {
 	return e.weight
 }
+
+/* An Edge's payload. */
+func (e *EdgeOf[P]) Payload() P {
+	return e.payload
+}
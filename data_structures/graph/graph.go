@@ -9,23 +9,33 @@ import (
 	"strconv"
 )
 
-type Graph struct {
-	E, V int
-	adj  [][]Edge
-	deg  []int
+/* Undirected Graph with vertex payloads VP & edge payloads EP. */
+type GraphOf[VP, EP any] struct {
+	E, V     int
+	adj      [][]EdgeOf[EP]
+	deg      []int
+	vertices []VP
 }
 
+type Graph = GraphOf[struct{}, struct{}]
+
 /* Create a Undirected Graph with V vertices. */
 func NewGraph(V int) *Graph {
+	return NewGraphOf[struct{}, struct{}](V)
+}
+
+/* Create a Undirected Graph with V zero-payload vertices. */
+func NewGraphOf[VP, EP any](V int) *GraphOf[VP, EP] {
 	if V < 0 {
 		panic("negative number of vertices")
 	}
 
-	return &Graph{
-		E:   0,
-		V:   V,
-		adj: make([][]Edge, V),
-		deg: make([]int, V),
+	return &GraphOf[VP, EP]{
+		E:        0,
+		V:        V,
+		adj:      make([][]EdgeOf[EP], V),
+		deg:      make([]int, V),
+		vertices: make([]VP, V),
 	}
 }
 
@@ -52,11 +62,7 @@ func NewGraphIO(r io.Reader) *Graph {
 		panic("negative number of vertices")
 	}
 
-	G := &Graph{
-		V:   V,
-		adj: make([][]Edge, V),
-		deg: make([]int, V),
-	}
+	G := NewGraph(V)
 
 	E := readInt()
 	if E < 0 {
@@ -67,14 +73,14 @@ func NewGraphIO(r io.Reader) *Graph {
 		from := readInt()
 		to := readInt()
 		weight := readInt()
-		G.AddEdge(Edge{from, to, weight})
+		G.AddEdge(*NewEdge(from, to, weight))
 	}
 
 	return G
 }
 
 /* Add an edge onto the Undirected Graph. */
-func (G *Graph) AddEdge(e Edge) {
+func (G *GraphOf[VP, EP]) AddEdge(e EdgeOf[EP]) {
 	from, to := e.v, e.w
 	G.IsVertexOf(from)
 	G.IsVertexOf(to)
@@ -87,9 +93,9 @@ func (G *Graph) AddEdge(e Edge) {
 }
 
 /* Adjacency List (edges) of a given vertex. */
-func (G *Graph) Adjacent(v int) iter.Seq[Edge] {
+func (G *GraphOf[VP, EP]) Adjacent(v int) iter.Seq[EdgeOf[EP]] {
 	G.IsVertexOf(v)
-	return func(yield func(Edge) bool) {
+	return func(yield func(EdgeOf[EP]) bool) {
 		N := len(G.adj[v])
 		for i := range N {
 			if !yield(G.adj[v][i]) {
@@ -100,28 +106,51 @@ func (G *Graph) Adjacent(v int) iter.Seq[Edge] {
 }
 
 /* Degree of a Undirected Graph's vertex. */
-func (G *Graph) Degree(v int) int {
+func (G *GraphOf[VP, EP]) Degree(v int) int {
 	G.IsVertexOf(v)
 	return G.deg[v]
 }
 
-/* All edges from a Undirected Graph. */
-func (G *Graph) Edges() iter.Seq[Edge] {
-	return func(yield func(Edge) bool) {
-		for v := range G.V {
-			for e := range G.Adjacent(v) {
-				if v > e.Other(v) {
-					if !yield(e) {
-						return
-					}
+/* All edges from a Undirected Graph, self-loops included once. */
+func (G *GraphOf[VP, EP]) Edges() iter.Seq[EdgeOf[EP]] {
+	return undirectedEdges(G.V, G.Adjacent)
+}
+
+/* Each undirected edge once, from its larger endpoint. */
+func undirectedEdges[P any](V int, adjacent func(int) iter.Seq[EdgeOf[P]]) iter.Seq[EdgeOf[P]] {
+	return func(yield func(EdgeOf[P]) bool) {
+		for v := range V {
+			loops := 0
+			for e := range adjacent(v) {
+				w := e.Other(v)
+
+				// A self-loop is listed twice in its adjacency.
+				if w == v {
+					loops++
+				}
+
+				if (w < v || w == v && loops%2 == 1) && !yield(e) {
+					return
 				}
 			}
 		}
 	}
 }
 
+/* Payload of a vertex. */
+func (G *GraphOf[VP, EP]) Vertex(v int) VP {
+	G.IsVertexOf(v)
+	return G.vertices[v]
+}
+
+/* Attach a payload to a vertex. */
+func (G *GraphOf[VP, EP]) SetVertex(v int, payload VP) {
+	G.IsVertexOf(v)
+	G.vertices[v] = payload
+}
+
 /* Validate if a vertex belongs to a Directed Graph. */
-func (G *Graph) IsVertexOf(v int) {
+func (G *GraphOf[VP, EP]) IsVertexOf(v int) {
 	if v < 0 || v >= G.V {
 		panic("vertex out of bounds")
 	}
@@ -0,0 +1,199 @@
+/* API: Payload Text Format */
+
+package graph
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/*
+Same layout as NewGraphIO with payload tokens added: V, E, then 1 payload
+per vertex, then E lines of 'v w weight payload'. Payloads are encoded as
+single whitespace-free tokens.
+*/
+
+var ErrMalformedText = errors.New("graph: malformed text input")
+
+/* Create an Undirected Graph with payloads from input stream. */
+func NewGraphOfIO[VP, EP any](
+	r io.Reader, vertex func(string) (VP, error), edge func(string) (EP, error),
+) (*GraphOf[VP, EP], error) {
+	var G *GraphOf[VP, EP]
+	err := readPayloads(r, vertex, edge,
+		func(V int) { G = NewGraphOf[VP, EP](V) },
+		func(v int, p VP) { G.vertices[v] = p },
+		func(e EdgeOf[EP]) { G.AddEdge(e) },
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return G, nil
+}
+
+/* Create a Directed Graph with payloads from input stream. */
+func NewDigraphOfIO[VP, EP any](
+	r io.Reader, vertex func(string) (VP, error), edge func(string) (EP, error),
+) (*DigraphOf[VP, EP], error) {
+	var G *DigraphOf[VP, EP]
+	err := readPayloads(r, vertex, edge,
+		func(V int) { G = NewDigraphOf[VP, EP](V) },
+		func(v int, p VP) { G.vertices[v] = p },
+		func(e EdgeOf[EP]) { G.AddEdge(e) },
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return G, nil
+}
+
+/* Write the Undirected Graph with payloads, each edge (self-loops included) once. */
+func (G *GraphOf[VP, EP]) WriteIO(w io.Writer, vertex func(VP) string, edge func(EP) string) error {
+	edges := slices.Collect(G.Edges())
+	return writePayloads(w, G.vertices, edges, vertex, edge)
+}
+
+/* Write the Directed Graph with payloads. */
+func (G *DigraphOf[VP, EP]) WriteIO(w io.Writer, vertex func(VP) string, edge func(EP) string) error {
+	var edges []EdgeOf[EP]
+	for v := range G.V {
+		edges = append(edges, G.adj[v]...)
+	}
+
+	return writePayloads(w, G.vertices, edges, vertex, edge)
+}
+
+func readPayloads[VP, EP any](
+	r io.Reader, vertex func(string) (VP, error), edge func(string) (EP, error),
+	create func(int), setVertex func(int, VP), addEdge func(EdgeOf[EP]),
+) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+
+	token := func(what string) (string, error) {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return "", err
+			}
+
+			return "", fmt.Errorf("%w: missing %s", ErrMalformedText, what)
+		}
+
+		return scanner.Text(), nil
+	}
+
+	readInt := func(what string) (int, error) {
+		tok, err := token(what)
+		if err != nil {
+			return 0, err
+		}
+
+		val, err := strconv.Atoi(tok)
+		if err != nil {
+			return 0, fmt.Errorf("%w: bad %s %q", ErrMalformedText, what, tok)
+		}
+
+		return val, nil
+	}
+
+	V, err := readInt("vertex count")
+	if err != nil {
+		return err
+	}
+
+	E, err := readInt("edge count")
+	if err != nil {
+		return err
+	}
+
+	if V < 0 || E < 0 {
+		return fmt.Errorf("%w: negative size", ErrMalformedText)
+	}
+
+	create(V)
+	for v := range V {
+		tok, err := token("vertex payload")
+		if err != nil {
+			return err
+		}
+
+		p, err := vertex(tok)
+		if err != nil {
+			return fmt.Errorf("%w: vertex %d: %w", ErrMalformedText, v, err)
+		}
+
+		setVertex(v, p)
+	}
+
+	for i := range E {
+		var ends [3]int
+		for k, what := range []string{"edge tail", "edge head", "edge weight"} {
+			if ends[k], err = readInt(what); err != nil {
+				return err
+			}
+		}
+
+		if ends[0] < 0 || ends[0] >= V || ends[1] < 0 || ends[1] >= V {
+			return fmt.Errorf("%w: edge %d: vertex out of bounds", ErrMalformedText, i)
+		}
+
+		tok, err := token("edge payload")
+		if err != nil {
+			return err
+		}
+
+		p, err := edge(tok)
+		if err != nil {
+			return fmt.Errorf("%w: edge %d: %w", ErrMalformedText, i, err)
+		}
+
+		addEdge(EdgeOf[EP]{p, ends[0], ends[1], ends[2]})
+	}
+
+	return nil
+}
+
+func writePayloads[VP, EP any](
+	w io.Writer, vertices []VP, edges []EdgeOf[EP], vertex func(VP) string, edge func(EP) string,
+) error {
+	single := func(tok string) error {
+		if tok == "" || strings.ContainsFunc(tok, unicode.IsSpace) {
+			return fmt.Errorf("%w: payload %q isn't a single token", ErrMalformedText, tok)
+		}
+
+		return nil
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "%d\n%d\n", len(vertices), len(edges))
+
+	for _, p := range vertices {
+		tok := vertex(p)
+		if err := single(tok); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(out, tok)
+	}
+
+	for _, e := range edges {
+		tok := edge(e.payload)
+		if err := single(tok); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(out, "%d %d %d %s\n", e.v, e.w, e.weight, tok)
+	}
+
+	return out.Flush()
+}
@@ -0,0 +1,135 @@
+/* Data Structure: Array-backed Union-Find */
+
+package unionfind
+
+import "iter"
+
+/* Union-Find over the dense integers 0..N-1, without any hashing. */
+type IntUnionFind struct {
+	count  int
+	parent []int
+	rank   []int
+	size   []int
+	next   []int // circular list of each component's members
+}
+
+/* Create a Union-Find with each of 0..N-1 in its own set. */
+func NewIntUnionFind(N int) *IntUnionFind {
+	if N < 0 {
+		panic("negative number of elements")
+	}
+
+	uf := &IntUnionFind{
+		count:  N,
+		parent: make([]int, N),
+		rank:   make([]int, N),
+		size:   make([]int, N),
+		next:   make([]int, N),
+	}
+
+	for i := range N {
+		uf.parent[i] = i
+		uf.size[i] = 1
+		uf.next[i] = i
+	}
+
+	return uf
+}
+
+/* Add the next integer as a singleton set, return it. */
+func (uf *IntUnionFind) Add() int {
+	id := len(uf.parent)
+	uf.parent = append(uf.parent, id)
+	uf.rank = append(uf.rank, 0)
+	uf.size = append(uf.size, 1)
+	uf.next = append(uf.next, id)
+	uf.count++
+
+	return id
+}
+
+/* Representative of the set containing x. */
+func (uf *IntUnionFind) Find(x int) int {
+	uf.IsElementOf(x)
+	return uf.find(x)
+}
+
+/* Merge the sets containing x & y. */
+func (uf *IntUnionFind) Union(x, y int) bool {
+	rx, ry := uf.Find(x), uf.Find(y)
+	if rx == ry {
+		return false
+	}
+
+	if uf.rank[rx] < uf.rank[ry] { // Union by Rank
+		rx, ry = ry, rx
+	} else if uf.rank[rx] == uf.rank[ry] {
+		uf.rank[rx]++
+	}
+
+	uf.parent[ry] = rx
+	uf.size[rx] += uf.size[ry]
+	uf.next[rx], uf.next[ry] = uf.next[ry], uf.next[rx] // Splice members
+	uf.count--
+
+	return true
+}
+
+/* Check if x & y belong to the same set. */
+func (uf *IntUnionFind) Connected(x, y int) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+/* Number of elements in the set containing x. */
+func (uf *IntUnionFind) Size(x int) int {
+	return uf.size[uf.Find(x)]
+}
+
+/* Number of disjoint sets. */
+func (uf *IntUnionFind) Count() int { return uf.count }
+
+/* Number of elements. */
+func (uf *IntUnionFind) Len() int { return len(uf.parent) }
+
+/* All elements in the set containing x. */
+func (uf *IntUnionFind) Members(x int) iter.Seq[int] {
+	uf.IsElementOf(x)
+	return func(yield func(int) bool) {
+		for id := x; ; {
+			if !yield(id) {
+				return
+			}
+
+			if id = uf.next[id]; id == x {
+				return
+			}
+		}
+	}
+}
+
+/* Representative of every set. */
+func (uf *IntUnionFind) Roots() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for id := range uf.parent {
+			if uf.parent[id] == id && !yield(id) {
+				return
+			}
+		}
+	}
+}
+
+/* Validate if an integer belongs to the Union-Find. */
+func (uf *IntUnionFind) IsElementOf(x int) {
+	if x < 0 || x >= len(uf.parent) {
+		panic("element not found")
+	}
+}
+
+func (uf *IntUnionFind) find(i int) int {
+	for i != uf.parent[i] {
+		uf.parent[i] = uf.parent[uf.parent[i]] // Half-way compression
+		i = uf.parent[i]
+	}
+
+	return i
+}
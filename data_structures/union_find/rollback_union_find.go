@@ -0,0 +1,169 @@
+/* Data Structure: Rollback Union-Find */
+
+package unionfind
+
+import "iter"
+
+type RollbackUnionFind[T comparable] struct {
+	count   int
+	parent  []int
+	size    []int
+	next    []int
+	items   []T
+	index   map[T]int
+	history []int // absorbed roots, -1 for failed unions
+}
+
+/* Create a Rollback Union-Find with each element in its own set. */
+func NewRollbackUnionFind[T comparable](items ...T) *RollbackUnionFind[T] {
+	uf := &RollbackUnionFind[T]{
+		index: make(map[T]int, len(items)),
+	}
+
+	for _, x := range items {
+		uf.Add(x)
+	}
+
+	return uf
+}
+
+/* Add a new element as a singleton set (not undoable). */
+func (uf *RollbackUnionFind[T]) Add(x T) bool {
+	if _, ok := uf.index[x]; ok {
+		return false
+	}
+
+	id := len(uf.items)
+	uf.index[x] = id
+	uf.items = append(uf.items, x)
+	uf.parent = append(uf.parent, id)
+	uf.size = append(uf.size, 1)
+	uf.next = append(uf.next, id)
+	uf.count++
+
+	return true
+}
+
+/* Representative element of the set containing x. */
+func (uf *RollbackUnionFind[T]) Find(x T) T {
+	return uf.items[uf.find(uf.idOf(x))]
+}
+
+/* Merge the sets containing x & y, recording it for Undo(). */
+func (uf *RollbackUnionFind[T]) Union(x, y T) bool {
+	rx, ry := uf.find(uf.idOf(x)), uf.find(uf.idOf(y))
+	if rx == ry {
+		uf.history = append(uf.history, -1)
+		return false
+	}
+
+	if uf.size[rx] < uf.size[ry] { // Union by Size
+		rx, ry = ry, rx
+	}
+
+	uf.parent[ry] = rx
+	uf.size[rx] += uf.size[ry]
+	uf.next[rx], uf.next[ry] = uf.next[ry], uf.next[rx]
+	uf.count--
+	uf.history = append(uf.history, ry)
+
+	return true
+}
+
+/* Revert the latest Union() call. */
+func (uf *RollbackUnionFind[T]) Undo() bool {
+	N := len(uf.history)
+	if N == 0 {
+		return false
+	}
+
+	ry := uf.history[N-1]
+	uf.history = uf.history[:N-1]
+	if ry == -1 {
+		return true
+	}
+
+	// Splicing twice restores both member lists.
+	rx := uf.parent[ry]
+	uf.parent[ry] = ry
+	uf.size[rx] -= uf.size[ry]
+	uf.next[rx], uf.next[ry] = uf.next[ry], uf.next[rx]
+	uf.count++
+
+	return true
+}
+
+/* Current version, to be passed to Rollback() later. */
+func (uf *RollbackUnionFind[T]) Snapshot() int { return len(uf.history) }
+
+/* Revert every Union() since the given snapshot. */
+func (uf *RollbackUnionFind[T]) Rollback(snapshot int) {
+	if snapshot < 0 || snapshot > len(uf.history) {
+		panic("invalid snapshot")
+	}
+
+	for len(uf.history) > snapshot {
+		uf.Undo()
+	}
+}
+
+/* Check if x & y belong to the same set. */
+func (uf *RollbackUnionFind[T]) Connected(x, y T) bool {
+	return uf.find(uf.idOf(x)) == uf.find(uf.idOf(y))
+}
+
+/* Number of elements in the set containing x. */
+func (uf *RollbackUnionFind[T]) Size(x T) int {
+	return uf.size[uf.find(uf.idOf(x))]
+}
+
+/* Number of disjoint sets. */
+func (uf *RollbackUnionFind[T]) Count() int { return uf.count }
+
+/* Number of elements. */
+func (uf *RollbackUnionFind[T]) Len() int { return len(uf.items) }
+
+/* All elements in the set containing x. */
+func (uf *RollbackUnionFind[T]) Members(x T) iter.Seq[T] {
+	start := uf.idOf(x)
+	return func(yield func(T) bool) {
+		for id := start; ; {
+			if !yield(uf.items[id]) {
+				return
+			}
+
+			if id = uf.next[id]; id == start {
+				return
+			}
+		}
+	}
+}
+
+/* Representative element of every set. */
+func (uf *RollbackUnionFind[T]) Roots() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for id := range uf.parent {
+			if uf.parent[id] == id && !yield(uf.items[id]) {
+				return
+			}
+		}
+	}
+}
+
+/* No path compression, so that every Union() stays undoable. */
+func (uf *RollbackUnionFind[T]) find(i int) int {
+	for i != uf.parent[i] {
+		i = uf.parent[i]
+	}
+
+	return i
+}
+
+func (uf *RollbackUnionFind[T]) idOf(x T) int {
+	id, ok := uf.index[x]
+	if !ok {
+		panic("element not found")
+	}
+
+	return id
+}
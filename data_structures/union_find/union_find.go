@@ -0,0 +1,95 @@
+/* Data Structure: Union-Find */
+
+package unionfind
+
+import "iter"
+
+type UnionFind[T comparable] struct {
+	ids   *IntUnionFind
+	items []T       // ID -> element
+	index map[T]int // element -> ID
+}
+
+/* Create a Union-Find with each element in its own set. */
+func NewUnionFind[T comparable](items ...T) *UnionFind[T] {
+	uf := &UnionFind[T]{
+		ids:   NewIntUnionFind(0),
+		index: make(map[T]int, len(items)),
+	}
+
+	for _, x := range items {
+		uf.Add(x)
+	}
+
+	return uf
+}
+
+/* Add a new element as a singleton set. */
+func (uf *UnionFind[T]) Add(x T) bool {
+	if _, ok := uf.index[x]; ok {
+		return false
+	}
+
+	uf.index[x] = uf.ids.Add()
+	uf.items = append(uf.items, x)
+
+	return true
+}
+
+/* Representative element of the set containing x. */
+func (uf *UnionFind[T]) Find(x T) T {
+	return uf.items[uf.ids.find(uf.idOf(x))]
+}
+
+/* Merge the sets containing x & y. */
+func (uf *UnionFind[T]) Union(x, y T) bool {
+	return uf.ids.Union(uf.idOf(x), uf.idOf(y))
+}
+
+/* Check if x & y belong to the same set. */
+func (uf *UnionFind[T]) Connected(x, y T) bool {
+	return uf.ids.Connected(uf.idOf(x), uf.idOf(y))
+}
+
+/* Number of elements in the set containing x. */
+func (uf *UnionFind[T]) Size(x T) int {
+	return uf.ids.Size(uf.idOf(x))
+}
+
+/* Number of disjoint sets. */
+func (uf *UnionFind[T]) Count() int { return uf.ids.Count() }
+
+/* Number of elements. */
+func (uf *UnionFind[T]) Len() int { return len(uf.items) }
+
+/* All elements in the set containing x. */
+func (uf *UnionFind[T]) Members(x T) iter.Seq[T] {
+	members := uf.ids.Members(uf.idOf(x))
+	return func(yield func(T) bool) {
+		for id := range members {
+			if !yield(uf.items[id]) {
+				return
+			}
+		}
+	}
+}
+
+/* Representative element of every set. */
+func (uf *UnionFind[T]) Roots() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for id := range uf.ids.Roots() {
+			if !yield(uf.items[id]) {
+				return
+			}
+		}
+	}
+}
+
+func (uf *UnionFind[T]) idOf(x T) int {
+	id, ok := uf.index[x]
+	if !ok {
+		panic("element not found")
+	}
+
+	return id
+}
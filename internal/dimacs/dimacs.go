@@ -0,0 +1,53 @@
+/* API: DIMACS Line Scanning (shared by the graph & flow network formats) */
+
+package dimacs
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var ErrMalformed = errors.New("dimacs: malformed input")
+
+/* Feed non-comment DIMACS lines (1-based numbers) to a handler. */
+func Scan(r io.Reader, handle func(int, []string) error) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] == "c" {
+			continue
+		}
+
+		if handleErr := handle(line, fields); handleErr != nil {
+			return handleErr
+		}
+	}
+
+	return scanner.Err()
+}
+
+/* Parse exactly n integers starting from fields[from]. */
+func Ints(fields []string, from, n int) ([]int, error) {
+	if len(fields) != from+n {
+		return nil, fmt.Errorf("%w: want %d numbers", ErrMalformed, n)
+	}
+
+	nums := make([]int, n)
+	for i := range n {
+		val, parseErr := strconv.Atoi(fields[from+i])
+		if parseErr != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformed, parseErr)
+		}
+
+		nums[i] = val
+	}
+
+	return nums, nil
+}